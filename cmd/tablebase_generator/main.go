@@ -0,0 +1,44 @@
+// tablebase_generator 3x3の全局面を解析したtablebaseファイルを生成する.
+//   生成したファイルはTABLEBASE_PATHに指定するとserverの起動時に読み込まれる.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/tablebase"
+)
+
+func main() {
+	out := flag.String("out", "tablebase.bin", "output file path")
+	flag.Parse()
+
+	rule := battle.NewRule()
+	root := rule.OpenBattle(battle.DefaultRuleSet())
+	for _, id := range []string{"player_a", "player_b"} {
+		if err := rule.Declaration(root, id); err != nil {
+			log.Fatalf("failed to Declaration: %v", err)
+		}
+	}
+
+	tb, err := tablebase.Generate(rule, root, log.Printf)
+	if err != nil {
+		log.Fatalf("failed to Generate: %v", err)
+	}
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatalf("failed to Create: %v", err)
+	}
+	if err := tb.Write(f); err != nil {
+		_ = f.Close()
+		log.Fatalf("failed to Write: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatalf("failed to Close: %v", err)
+	}
+
+	log.Printf("wrote %d positions to %s", tb.Len(), *out)
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
+	"time"
 
 	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/tablebase"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/env"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/grpc_server"
@@ -14,26 +16,46 @@ import (
 	"go.uber.org/zap"
 )
 
+// reconcileInterval ratingに反映されていないゲームを確認する間隔.
+const reconcileInterval = 10 * time.Minute
+
 func setup() grpc_server.GRPCServer {
 	zapLogger := loggers.NewZapLogger(env.Server.RunMode)
 
+	// tablebase
+	var tb *tablebase.Tablebase
+	if env.Server.TablebasePath != "" {
+		var err error
+		if tb, err = tablebase.Load(env.Server.TablebasePath); err != nil {
+			zapLogger.Panic("failed to load tablebase", zap.String("path", env.Server.TablebasePath), zap.Error(err))
+		}
+		zapLogger.Info("tablebase was loaded", zap.String("path", env.Server.TablebasePath), zap.Int("positions", tb.Len()))
+	}
+
 	// factories
-	dFactory := domains.NewFactory()
+	dFactory := domains.NewFactory(tb)
 	gwFactory := infrastructures.NewFactory()
 	repoFactory := repositories.NewFactory(gwFactory)
 	iFactory := interactors.NewFactory(dFactory, repoFactory)
 
 	// interface_adapters
 	controller := controllers.NewTicTacToeBattleController(zapLogger, iFactory)
+	extController := controllers.NewTicTacToeBattleExtController(zapLogger, iFactory, env.Server.AdminToken)
 	// grpc_service_register
-	grpcServiceRegister := grpc_server.NewControllerRegister(controller)
+	grpcServiceRegister := grpc_server.NewControllerRegister(controller, extController)
+	// interceptors
+	interceptors := []grpc_server.Interceptor{
+		controllers.NewErrorInterceptor(),
+	}
 
 	// initializer & closer
 	init := func() {
 		if err := gwFactory.MemDBClient().Ping(context.Background()); err != nil {
-			zapLogger.Panic("failed to ping to redis", zap.Error(err))
+			zapLogger.Panic("failed to ping to memdb", zap.String("driver", env.Server.MemDBDriver), zap.Error(err))
 		}
-		zapLogger.Info("ping to redis was successful")
+		zapLogger.Info("ping to memdb was successful", zap.String("driver", env.Server.MemDBDriver))
+
+		go reconcileRatings(zapLogger, iFactory.BattleInteractor())
 	}
 	closer := func() {}
 
@@ -42,9 +64,24 @@ func setup() grpc_server.GRPCServer {
 		env.Server.PORT,
 		env.Server.RunMode,
 		grpcServiceRegister,
+		interceptors,
 		init,
 		closer,
 	)
 
 	return grpcServer
 }
+
+// reconcileRatings 起動時と一定間隔で、終了時にratingへ反映できなかったゲームを反映する.
+func reconcileRatings(logger *zap.Logger, bi interactors.BattleInteractor) {
+	ctx := loggers.LoggerToContext(context.Background(), logger)
+	ticker := time.NewTicker(reconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := bi.ReconcileRatings(ctx); err != nil {
+			logger.Error("failed to ReconcileRatings", zap.Error(err))
+		}
+		<-ticker.C
+	}
+}
@@ -0,0 +1,19 @@
+package exceptions
+
+import (
+	"golang.org/x/xerrors"
+)
+
+// ConflictError is
+//   楽観的排他制御で、読み込み後に他のリクエストによって更新されていた場合に使用する.
+type ConflictError struct {
+	error
+}
+
+func IsConflictError(err error) bool {
+	return xerrors.As(err, &ConflictError{})
+}
+
+func NewConflictError(text string) ConflictError {
+	return ConflictError{error: xerrors.New(text)}
+}
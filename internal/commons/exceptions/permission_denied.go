@@ -0,0 +1,19 @@
+package exceptions
+
+import (
+	"golang.org/x/xerrors"
+)
+
+type (
+	PermissionDeniedError struct {
+		error
+	}
+)
+
+func IsPermissionDeniedError(err error) bool {
+	return xerrors.As(err, &PermissionDeniedError{})
+}
+
+func NewPermissionDeniedError(text string) PermissionDeniedError {
+	return PermissionDeniedError{error: xerrors.New(text)}
+}
@@ -0,0 +1,19 @@
+package exceptions
+
+import (
+	"golang.org/x/xerrors"
+)
+
+type (
+	ResourceExhaustedError struct {
+		error
+	}
+)
+
+func IsResourceExhaustedError(err error) bool {
+	return xerrors.As(err, &ResourceExhaustedError{})
+}
+
+func NewResourceExhaustedError(text string) ResourceExhaustedError {
+	return ResourceExhaustedError{error: xerrors.New(text)}
+}
@@ -0,0 +1,19 @@
+package exceptions
+
+import (
+	"golang.org/x/xerrors"
+)
+
+type (
+	UnauthenticatedError struct {
+		error
+	}
+)
+
+func IsUnauthenticatedError(err error) bool {
+	return xerrors.As(err, &UnauthenticatedError{})
+}
+
+func NewUnauthenticatedError(text string) UnauthenticatedError {
+	return UnauthenticatedError{error: xerrors.New(text)}
+}
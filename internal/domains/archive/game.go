@@ -0,0 +1,101 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+)
+
+// ゲームの結果.
+const (
+	ResultPlayerAWin Result = "player_a_win"
+	ResultPlayerBWin Result = "player_b_win"
+	ResultDraw       Result = "draw"
+)
+
+// 決着の理由.
+const (
+	// ReasonLine ラインが揃った.
+	ReasonLine Reason = "line"
+	// ReasonTimeout 持ち時間が切れた.
+	ReasonTimeout Reason = "timeout"
+	// ReasonResign 投了した.
+	ReasonResign Reason = "resign"
+	// ReasonAgreement 引き分けの提案に応じた.
+	ReasonAgreement Reason = "agreement"
+	// ReasonDrawRule 千日手、手数の上限または着手できる手が無いため引き分けとなった.
+	ReasonDrawRule Reason = "draw_rule"
+)
+
+type (
+	Result string
+	Reason string
+
+	// Game is
+	//   終了したゲームの記録. roomの削除後も残り、プレイヤーの戦績の集計に使用する.
+	Game struct {
+		GameID      battle.GameID      `json:"game_id"`
+		RoomID      room.ID            `json:"room_id"`
+		PlayerAID   string             `json:"player_a_id"`
+		PlayerBID   string             `json:"player_b_id"`
+		RuleSet     battle.RuleSet     `json:"rule_set"`
+		TimeControl battle.TimeControl `json:"time_control"`
+		Ranked      bool               `json:"ranked"`
+		Result      Result             `json:"result"`
+		Reason      Reason             `json:"reason"`
+		// WinLines 勝者の揃った全てのラインのマスの番号. 引き分けや投了では空.
+		WinLines   [][]int        `json:"win_lines"`
+		MoveCount  int            `json:"move_count"`
+		Moves      []*battle.Move `json:"moves"`
+		StartedAt  time.Time      `json:"started_at"`
+		FinishedAt time.Time      `json:"finished_at"`
+	}
+)
+
+// NewGame 終了したbと、そのゲームのmovesから記録を作成する. 開始と終了の時刻は最初と最後のmoveのもの.
+func NewGame(b *battle.Battle, moves []*battle.Move) *Game {
+	g := &Game{
+		GameID:      b.GameID,
+		RoomID:      b.RoomID,
+		PlayerAID:   b.PlayerAID,
+		PlayerBID:   b.PlayerBID,
+		RuleSet:     b.RuleSet,
+		TimeControl: b.TimeControl(),
+		Ranked:      b.Ranked,
+		WinLines:    b.WinningCells(),
+		MoveCount:   b.MoveCount,
+		Moves:       moves,
+	}
+	if len(moves) > 0 {
+		g.StartedAt = moves[0].PlayedAt
+		g.FinishedAt = moves[len(moves)-1].PlayedAt
+	}
+
+	switch b.Winner() {
+	case tictactoe_battle.Player_PLAYER_A:
+		g.Result = ResultPlayerAWin
+	case tictactoe_battle.Player_PLAYER_B:
+		g.Result = ResultPlayerBWin
+	default:
+		g.Result = ResultDraw
+	}
+
+	switch {
+	case b.State == management_state.PlayerAResigned || b.State == management_state.PlayerBResigned:
+		g.Reason = ReasonResign
+	case b.State == management_state.DrawAgreed:
+		g.Reason = ReasonAgreement
+	case b.State == management_state.Draw:
+		g.Reason = ReasonDrawRule
+	case len(g.WinLines) == 0:
+		// 勝敗が決まってラインが無いのは時間切れのみ
+		g.Reason = ReasonTimeout
+	default:
+		g.Reason = ReasonLine
+	}
+
+	return g
+}
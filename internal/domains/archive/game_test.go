@@ -0,0 +1,37 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+)
+
+func TestNewGame(t *testing.T) {
+	tests := []struct {
+		name       string
+		state      management_state.State
+		winLines   []tictactoe_battle.WinLine
+		wantResult Result
+		wantReason Reason
+	}{
+		{name: "line", state: management_state.PlayerAWin, winLines: []tictactoe_battle.WinLine{tictactoe_battle.WinLine_WIN_LINE_7}, wantResult: ResultPlayerAWin, wantReason: ReasonLine},
+		{name: "timeout", state: management_state.PlayerBWin, wantResult: ResultPlayerBWin, wantReason: ReasonTimeout},
+		{name: "resign", state: management_state.PlayerBResigned, wantResult: ResultPlayerAWin, wantReason: ReasonResign},
+		{name: "agreement", state: management_state.DrawAgreed, wantResult: ResultDraw, wantReason: ReasonAgreement},
+		{name: "repetition", state: management_state.Draw, wantResult: ResultDraw, wantReason: ReasonDrawRule},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := battle.NewRule().OpenBattle(battle.DefaultRuleSet())
+			b.State = tt.state
+			b.WinLines = tt.winLines
+
+			g := NewGame(b, nil)
+			if g.Result != tt.wantResult || g.Reason != tt.wantReason {
+				t.Fatalf("wanted %s by %s but got %s by %s", tt.wantResult, tt.wantReason, g.Result, g.Reason)
+			}
+		})
+	}
+}
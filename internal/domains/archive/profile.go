@@ -0,0 +1,122 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+)
+
+// プレイヤーから見たゲームの結果.
+const (
+	OutcomeWin  Outcome = "win"
+	OutcomeLoss Outcome = "loss"
+	OutcomeDraw Outcome = "draw"
+)
+
+type (
+	Outcome string
+
+	// Match is
+	//   プレイヤーの視点から見た1ゲームの結果.
+	Match struct {
+		GameID     battle.GameID           `json:"game_id"`
+		Player     tictactoe_battle.Player `json:"player"`
+		OpponentID string                  `json:"opponent_id"`
+		Outcome    Outcome                 `json:"outcome"`
+		Reason     Reason                  `json:"reason"`
+		Mode       battle.Mode             `json:"mode"`
+		Ranked     bool                    `json:"ranked"`
+		MoveCount  int                     `json:"move_count"`
+		StartedAt  time.Time               `json:"started_at"`
+		FinishedAt time.Time               `json:"finished_at"`
+	}
+
+	// MatchHistory is
+	//   プレイヤーの過去のゲームの1ページ分. NextPageTokenが空の場合は最後のページ.
+	MatchHistory struct {
+		Matches       []Match `json:"matches"`
+		NextPageToken string  `json:"next_page_token"`
+	}
+
+	// Profile is
+	//   記録されたゲームから集計したプレイヤーの通算成績.
+	Profile struct {
+		PlayerID string `json:"player_id"`
+		Wins     int    `json:"wins"`
+		Losses   int    `json:"losses"`
+		Draws    int    `json:"draws"`
+		// Streak 直近の連勝数. 連敗中は負の値となり、直近のゲームが引き分けの場合は0.
+		Streak int `json:"streak"`
+		// FavoriteOpening 最初に駒を置いたマスで最も多いもの. 3x3の盤面のゲームのみ集計し、無い場合はPOSITION_UNDEFINED.
+		FavoriteOpening tictactoe_battle.Position `json:"favorite_opening"`
+	}
+)
+
+// MatchOf playerIDの視点から見たゲームの結果を返す. playerIDが対局者でない場合はfalse.
+func (g *Game) MatchOf(playerID string) (Match, bool) {
+	m := Match{
+		GameID:     g.GameID,
+		Reason:     g.Reason,
+		Mode:       g.RuleSet.Mode,
+		Ranked:     g.Ranked,
+		MoveCount:  g.MoveCount,
+		StartedAt:  g.StartedAt,
+		FinishedAt: g.FinishedAt,
+	}
+	switch playerID {
+	case "":
+		return Match{}, false
+	case g.PlayerAID:
+		m.Player = tictactoe_battle.Player_PLAYER_A
+		m.OpponentID = g.PlayerBID
+	case g.PlayerBID:
+		m.Player = tictactoe_battle.Player_PLAYER_B
+		m.OpponentID = g.PlayerAID
+	default:
+		return Match{}, false
+	}
+
+	switch {
+	case g.Result == ResultDraw:
+		m.Outcome = OutcomeDraw
+	case g.Result == ResultPlayerAWin && m.Player == tictactoe_battle.Player_PLAYER_A,
+		g.Result == ResultPlayerBWin && m.Player == tictactoe_battle.Player_PLAYER_B:
+		m.Outcome = OutcomeWin
+	default:
+		m.Outcome = OutcomeLoss
+	}
+
+	return m, true
+}
+
+// Opening playerが最初に持ち駒を置いたマスを返す. 3x3の盤面でない場合や駒を置いていない場合はfalse.
+func (g *Game) Opening(player tictactoe_battle.Player) (tictactoe_battle.Position, bool) {
+	if g.RuleSet.IsGobblet() {
+		return tictactoe_battle.Position_POSITION_UNDEFINED, false
+	}
+
+	for _, m := range g.Moves {
+		if m.Type == battle.MoveAttack && m.Player == player {
+			return m.Position, true
+		}
+	}
+	return tictactoe_battle.Position_POSITION_UNDEFINED, false
+}
+
+// Streak 新しい順のoutcomesの先頭から同じ結果が続く数を返す. 連敗は負の値となり、引き分けは0.
+func Streak(outcomes []Outcome) int {
+	streak := 0
+	for _, o := range outcomes {
+		if o != outcomes[0] {
+			break
+		}
+		switch o {
+		case OutcomeWin:
+			streak++
+		case OutcomeLoss:
+			streak--
+		}
+	}
+	return streak
+}
@@ -0,0 +1,63 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+)
+
+func TestStreak(t *testing.T) {
+	tests := []struct {
+		name     string
+		outcomes []Outcome
+		want     int
+	}{
+		{name: "no games", want: 0},
+		{name: "winning streak", outcomes: []Outcome{OutcomeWin, OutcomeWin, OutcomeDraw, OutcomeWin}, want: 2},
+		{name: "losing streak", outcomes: []Outcome{OutcomeLoss, OutcomeLoss, OutcomeWin}, want: -2},
+		{name: "draw ends streak", outcomes: []Outcome{OutcomeDraw, OutcomeWin}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Streak(tt.outcomes); got != tt.want {
+				t.Fatalf("wanted %d but got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGame_Opening(t *testing.T) {
+	var (
+		a      = tictactoe_battle.Player_PLAYER_A
+		b      = tictactoe_battle.Player_PLAYER_B
+		center = tictactoe_battle.Position_POSITION_X1Y1
+		corner = tictactoe_battle.Position_POSITION_X0Y0
+	)
+	moves := []*battle.Move{
+		battle.NewAttack(a, center, tictactoe_battle.Piece_PIECE_L),
+		battle.NewAttack(b, corner, tictactoe_battle.Piece_PIECE_L),
+	}
+
+	tests := []struct {
+		name    string
+		ruleSet battle.RuleSet
+		moves   []*battle.Move
+		player  tictactoe_battle.Player
+		want    tictactoe_battle.Position
+		wantOK  bool
+	}{
+		{name: "player a", ruleSet: battle.DefaultRuleSet(), moves: moves, player: a, want: center, wantOK: true},
+		{name: "player b", ruleSet: battle.DefaultRuleSet(), moves: moves, player: b, want: corner, wantOK: true},
+		{name: "no moves", ruleSet: battle.DefaultRuleSet(), moves: moves[:1], player: b, want: tictactoe_battle.Position_POSITION_UNDEFINED},
+		{name: "gobblet", ruleSet: battle.RuleSet{Mode: battle.ModeGobblet}, moves: moves, player: a, want: tictactoe_battle.Position_POSITION_UNDEFINED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Game{RuleSet: tt.ruleSet, Moves: tt.moves}
+			if got, ok := g.Opening(tt.player); got != tt.want || ok != tt.wantOK {
+				t.Fatalf("wanted (%s, %t) but got (%s, %t)", tt.want, tt.wantOK, got, ok)
+			}
+		})
+	}
+}
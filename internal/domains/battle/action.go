@@ -0,0 +1,38 @@
+package battle
+
+import (
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+)
+
+type (
+	// Action is
+	//   1手番の着手. Fromが定義されている場合は盤上の駒の移動、未定義の場合は持ち駒を置く.
+	//   駒を持ち上げた時点で勝敗が決まる移動はToが未定義となる.
+	Action struct {
+		From  tictactoe_battle.Position `json:"from"`
+		To    tictactoe_battle.Position `json:"to"`
+		Piece tictactoe_battle.Piece    `json:"piece"`
+	}
+)
+
+func (a Action) IsRelocation() bool {
+	return a.From != tictactoe_battle.Position_POSITION_UNDEFINED
+}
+
+// Play actionをbに適用する. 盤上の駒の移動はPickとAttackの2回の操作となる.
+//   Attackが失敗した場合はPickも適用しない.
+func Play(r Rule, b *Battle, player tictactoe_battle.Player, a Action) error {
+	if !a.IsRelocation() || b.PickedPosition != tictactoe_battle.Position_POSITION_UNDEFINED {
+		return r.Attack(b, player, a.To, a.Piece)
+	}
+
+	return commit(b, func(c *Battle) error {
+		if err := r.Pick(c, player, a.From, a.Piece); err != nil {
+			return err
+		}
+		if c.State.IsFinished() {
+			return nil
+		}
+		return r.Attack(c, player, a.To, a.Piece)
+	})
+}
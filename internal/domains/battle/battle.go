@@ -3,12 +3,15 @@ package battle
 import (
 	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
 )
 
 type (
 	Battle struct {
 		RoomID         room.ID                        `json:"room_id"`
+		GameID         GameID                         `json:"game_id"`
+		RuleSet        RuleSet                        `json:"rule_set"`
 		PlayerAID      string                         `json:"player_aid"`
 		PlayerAHolding *tictactoe_battle.Holding      `json:"player_a_holding"`
 		PlayerBID      string                         `json:"player_bid"`
@@ -18,5 +21,159 @@ type (
 		PickedPiece    tictactoe_battle.Piece         `json:"picked_piece"`
 		Field          []*tictactoe_battle.PieceStack `json:"field"`
 		WinLine        tictactoe_battle.WinLine       `json:"win_line"`
+		// WinLines 勝者の揃った全てのライン. WinLineはこの先頭.
+		WinLines []tictactoe_battle.WinLine `json:"win_lines"`
+		// MoveCount 駒を置いた回数(手数).
+		MoveCount int `json:"move_count"`
+		// Repetitions 局面(PositionHash)ごとの出現回数. 千日手の判定に使用する.
+		Repetitions map[uint64]int `json:"repetitions"`
+		// Ranked レーティング対象のroomか. room作成時に決まりResetでは変わらない.
+		Ranked bool `json:"ranked"`
+		// Gobblet ModeGobbletの盤面. ModeGobbletではField、Holding、WinLineは使用しない.
+		Gobblet *GobbletBoard `json:"gobblet,omitempty"`
+		// Clock 両プレイヤーの持ち時間. 持ち時間の無いroomではnil.
+		Clock *Clock `json:"clock,omitempty"`
+		// ResetRequestedBy 対局中にResetを要求したプレイヤー. 相手も要求した場合にResetする.
+		//   どちらかが手を指すと取り消される.
+		ResetRequestedBy tictactoe_battle.Player `json:"reset_requested_by,omitempty"`
+		// Series 同じ2人のプレイヤーによる再戦の通算成績. 再戦以外でResetすると消える.
+		Series Series `json:"series"`
+		// RematchRequestedBy ゲームの終了後に再戦を要求したプレイヤー.
+		RematchRequestedBy tictactoe_battle.Player `json:"rematch_requested_by,omitempty"`
+		// Ratings ranked roomで終了したゲームによる両プレイヤーのratingの変化. 記録されるまではnil.
+		Ratings *rating.Result `json:"ratings,omitempty"`
 	}
 )
+
+// PlayerOf loginIDがこのbattleで座っている席を返す. 席についていない場合はAUDIENCE.
+func (b *Battle) PlayerOf(loginID string) tictactoe_battle.Player {
+	switch {
+	case loginID == "":
+		return tictactoe_battle.Player_PLAYER_AUDIENCE
+	case loginID == b.PlayerAID:
+		return tictactoe_battle.Player_PLAYER_A
+	case loginID == b.PlayerBID:
+		return tictactoe_battle.Player_PLAYER_B
+	default:
+		return tictactoe_battle.Player_PLAYER_AUDIENCE
+	}
+}
+
+// Turn 手番のプレイヤーを返す. 引き分けの提案中は回答する側. 対局中でない場合はUNKNOWN.
+func (b *Battle) Turn() tictactoe_battle.Player {
+	return sideToMove(b.State)
+}
+
+// ResetAgreed playerがResetを要求した時点で両者の要求が揃うか.
+func (b *Battle) ResetAgreed(player tictactoe_battle.Player) bool {
+	return b.ResetRequestedBy == opponentOf(player)
+}
+
+// RematchAgreed playerが再戦を要求した時点で両者の要求が揃うか.
+func (b *Battle) RematchAgreed(player tictactoe_battle.Player) bool {
+	return b.RematchRequestedBy == opponentOf(player)
+}
+
+// Winner 勝者を返す. 勝敗が決まっていない場合はUNKNOWN.
+func (b *Battle) Winner() tictactoe_battle.Player {
+	switch b.State {
+	case management_state.PlayerAWin, management_state.PlayerBResigned:
+		return tictactoe_battle.Player_PLAYER_A
+	case management_state.PlayerBWin, management_state.PlayerAResigned:
+		return tictactoe_battle.Player_PLAYER_B
+	default:
+		return tictactoe_battle.Player_PLAYER_UNKNOWN
+	}
+}
+
+// OwnerAt posで一番上に見えている駒の持ち主を返す.
+func (b *Battle) OwnerAt(pos tictactoe_battle.Position) tictactoe_battle.Player {
+	return stackOwner(b.Field[pos])
+}
+
+// Clone battleのdeep copyを返す.
+func (b *Battle) Clone() *Battle {
+	ret := *b
+	ret.PlayerAHolding = cloneHolding(b.PlayerAHolding)
+	ret.PlayerBHolding = cloneHolding(b.PlayerBHolding)
+	if b.Field != nil {
+		ret.Field = make([]*tictactoe_battle.PieceStack, len(b.Field))
+		for i, s := range b.Field {
+			if s != nil {
+				ret.Field[i] = &tictactoe_battle.PieceStack{S: s.S, M: s.M, L: s.L}
+			}
+		}
+	}
+	if b.WinLines != nil {
+		ret.WinLines = append([]tictactoe_battle.WinLine{}, b.WinLines...)
+	}
+	if b.Gobblet != nil {
+		ret.Gobblet = b.Gobblet.clone()
+	}
+	if b.Clock != nil {
+		clock := *b.Clock
+		ret.Clock = &clock
+	}
+	if b.Ratings != nil {
+		ratings := *b.Ratings
+		ret.Ratings = &ratings
+	}
+	if b.Repetitions != nil {
+		ret.Repetitions = make(map[uint64]int, len(b.Repetitions))
+		for k, v := range b.Repetitions {
+			ret.Repetitions[k] = v
+		}
+	}
+	return &ret
+}
+
+// TimeControl battleの持ち時間の設定を返す. 持ち時間が無い場合はゼロ値.
+func (b *Battle) TimeControl() TimeControl {
+	if b.Clock == nil {
+		return TimeControl{}
+	}
+	return b.Clock.TimeControl
+}
+
+// WinningCells 勝者の揃った全てのラインをマスの番号で返す. ModeGobbletでも同じ形式となる.
+func (b *Battle) WinningCells() [][]int {
+	var ret [][]int
+	if b.Gobblet != nil {
+		for _, line := range b.Gobblet.WinLines {
+			ret = append(ret, append([]int{}, line...))
+		}
+		return ret
+	}
+
+	for _, wl := range b.WinLines {
+		if wl <= tictactoe_battle.WinLine_WIN_LINE_UNKNOWN || int(wl) > len(winLines) {
+			continue
+		}
+		var cells []int
+		for _, pos := range winLines[wl-1].positions {
+			cells = append(cells, int(pos))
+		}
+		ret = append(ret, cells)
+	}
+	return ret
+}
+
+// onField posが盤面のマスか.
+func (b *Battle) onField(pos tictactoe_battle.Position) bool {
+	return pos >= 0 && int(pos) < len(b.Field)
+}
+
+// fieldSize 盤面のマス数.
+func (b *Battle) fieldSize() int {
+	if b.Gobblet != nil {
+		return len(b.Gobblet.Cells)
+	}
+	return len(b.Field)
+}
+
+func cloneHolding(h *tictactoe_battle.Holding) *tictactoe_battle.Holding {
+	if h == nil {
+		return nil
+	}
+	return &tictactoe_battle.Holding{S: h.S, M: h.M, L: h.L}
+}
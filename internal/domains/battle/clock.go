@@ -0,0 +1,200 @@
+package battle
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+)
+
+// 持ち時間の方式.
+const (
+	// TimeControlFischer 初期の持ち時間から消費し、1手ごとにIncrementを加算する.
+	TimeControlFischer TimeControlType = "fischer"
+	// TimeControlPerMove 1手ごとにBaseの持ち時間が与えられ、余った時間は持ち越さない.
+	TimeControlPerMove TimeControlType = "per_move"
+)
+
+const (
+	// maxTimeControl 持ち時間の上限. roomはroom.TimeoutDurationの間更新が無いと削除されるため、1手の持ち時間をこれより長くしても意味は無い.
+	maxTimeControl = 15 * time.Minute
+)
+
+type (
+	TimeControlType string
+
+	// TimeControl is
+	//   roomごとの持ち時間の設定. ゼロ値は持ち時間なし.
+	TimeControl struct {
+		Type TimeControlType `json:"type,omitempty"`
+		// Base TimeControlFischerでは初期の持ち時間、TimeControlPerMoveでは1手ごとの持ち時間.
+		Base time.Duration `json:"base,omitempty"`
+		// Increment TimeControlFischerで1手ごとに加算する時間.
+		Increment time.Duration `json:"increment,omitempty"`
+	}
+
+	// Clock is
+	//   両プレイヤーの持ち時間. 手番のプレイヤーの残り時間はTurnStartedAtからの経過時間を差し引いたものとなる.
+	Clock struct {
+		TimeControl      TimeControl   `json:"time_control"`
+		PlayerARemaining time.Duration `json:"player_a_remaining"`
+		PlayerBRemaining time.Duration `json:"player_b_remaining"`
+		// TurnStartedAt 手番のプレイヤーが持ち時間を消費し始めた時刻. 対局中でない場合はゼロ値.
+		TurnStartedAt time.Time `json:"turn_started_at"`
+	}
+)
+
+// ParseTimeControl "fischer:5m+3s"または"per_move:30s"の形式からTimeControlを返す.
+func ParseTimeControl(s string) (TimeControl, error) {
+	invalid := exceptions.NewInvalidArgumentError(fmt.Sprintf("invalid time control: %s", s))
+
+	kind, value := s, ""
+	if i := strings.Index(s, ":"); i >= 0 {
+		kind, value = s[:i], s[i+1:]
+	}
+
+	var (
+		tc  = TimeControl{Type: TimeControlType(strings.ToLower(kind))}
+		err error
+	)
+	switch tc.Type {
+	case TimeControlFischer:
+		base, inc := value, "0s"
+		if i := strings.Index(value, "+"); i >= 0 {
+			base, inc = value[:i], value[i+1:]
+		}
+		if tc.Base, err = time.ParseDuration(base); err != nil {
+			return TimeControl{}, invalid
+		}
+		if tc.Increment, err = time.ParseDuration(inc); err != nil {
+			return TimeControl{}, invalid
+		}
+	case TimeControlPerMove:
+		if tc.Base, err = time.ParseDuration(value); err != nil {
+			return TimeControl{}, invalid
+		}
+	default:
+		return TimeControl{}, invalid
+	}
+
+	if err := tc.Validate(); err != nil {
+		return TimeControl{}, err
+	}
+	return tc, nil
+}
+
+// Validate TimeControlの値を検証する. ゼロ値は有効.
+func (tc TimeControl) Validate() error {
+	switch tc.Type {
+	case "":
+		if tc != (TimeControl{}) {
+			return exceptions.NewInvalidArgumentError("time control type is required")
+		}
+		return nil
+	case TimeControlFischer:
+	case TimeControlPerMove:
+		if tc.Increment != 0 {
+			return exceptions.NewInvalidArgumentError("per move time control does not accept an increment")
+		}
+	default:
+		return exceptions.NewInvalidArgumentError(fmt.Sprintf("unknown time control: %s", tc.Type))
+	}
+
+	if tc.Base <= 0 || tc.Base > maxTimeControl {
+		return exceptions.NewInvalidArgumentError(fmt.Sprintf("base time must be between 0 and %s: %s", maxTimeControl, tc.Base))
+	}
+	if tc.Increment < 0 || tc.Increment > maxTimeControl {
+		return exceptions.NewInvalidArgumentError(fmt.Sprintf("increment must be between 0 and %s: %s", maxTimeControl, tc.Increment))
+	}
+	return nil
+}
+
+// NewClock tcの持ち時間で止まっているClockを返す. tcがゼロ値の場合はnil.
+func NewClock(tc TimeControl) *Clock {
+	if tc.Type == "" {
+		return nil
+	}
+	return &Clock{
+		TimeControl:      tc,
+		PlayerARemaining: tc.Base,
+		PlayerBRemaining: tc.Base,
+	}
+}
+
+// IsRunning 手番のプレイヤーが持ち時間を消費しているか.
+func (c *Clock) IsRunning() bool {
+	return c != nil && !c.TurnStartedAt.IsZero()
+}
+
+// Remaining nowの時点でのplayerの残り時間を返す. 0未満にはならない.
+func (c *Clock) Remaining(b *Battle, player tictactoe_battle.Player, now time.Time) time.Duration {
+	ret := *c.remainingOf(player)
+	if c.IsRunning() && b.Turn() == player {
+		ret -= now.Sub(c.TurnStartedAt)
+	}
+	if ret < 0 {
+		return 0
+	}
+	return ret
+}
+
+// Deadline 手番のプレイヤーの持ち時間が切れる時刻を返す. 時計が止まっている場合はfalse.
+func (c *Clock) Deadline(b *Battle) (time.Time, bool) {
+	player := b.Turn()
+	if !c.IsRunning() || player == tictactoe_battle.Player_PLAYER_UNKNOWN {
+		return time.Time{}, false
+	}
+	return c.TurnStartedAt.Add(*c.remainingOf(player)), true
+}
+
+// Expired nowの時点で手番のプレイヤーの持ち時間が切れているか.
+func (c *Clock) Expired(b *Battle, now time.Time) bool {
+	deadline, ok := c.Deadline(b)
+	return ok && !now.Before(deadline)
+}
+
+// advance moverの操作をnowに適用した後の時計を進める.
+//   対局が始まった場合は時計を動かし、手番が移った場合はmoverの消費時間を差し引く.
+//   playedがfalseの引き分けの提案などでは消費時間を差し引くのみで、Incrementの加算や持ち時間の回復は行わない.
+func (c *Clock) advance(b *Battle, mover tictactoe_battle.Player, now time.Time, played bool) {
+	switch {
+	case mover == tictactoe_battle.Player_PLAYER_UNKNOWN:
+		if b.Turn() != tictactoe_battle.Player_PLAYER_UNKNOWN {
+			c.TurnStartedAt = now
+		}
+		return
+	case b.Turn() == mover:
+		// 駒を持ち上げただけの場合は手番が続く
+		return
+	}
+
+	remaining := c.remainingOf(mover)
+	switch {
+	case !played:
+		*remaining -= now.Sub(c.TurnStartedAt)
+	case c.TimeControl.Type == TimeControlFischer:
+		*remaining += c.TimeControl.Increment - now.Sub(c.TurnStartedAt)
+	case c.TimeControl.Type == TimeControlPerMove:
+		*remaining = c.TimeControl.Base
+	}
+
+	c.TurnStartedAt = now
+	if b.State.IsFinished() {
+		c.TurnStartedAt = time.Time{}
+	}
+}
+
+// flag playerの持ち時間を使い切った状態で時計を止める.
+func (c *Clock) flag(player tictactoe_battle.Player) {
+	*c.remainingOf(player) = 0
+	c.TurnStartedAt = time.Time{}
+}
+
+func (c *Clock) remainingOf(player tictactoe_battle.Player) *time.Duration {
+	if player == tictactoe_battle.Player_PLAYER_B {
+		return &c.PlayerBRemaining
+	}
+	return &c.PlayerARemaining
+}
@@ -0,0 +1,133 @@
+package battle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+)
+
+func TestParseTimeControl(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    TimeControl
+		wantErr bool
+	}{
+		{name: "fischer", s: "fischer:5m+3s", want: TimeControl{Type: TimeControlFischer, Base: 5 * time.Minute, Increment: 3 * time.Second}},
+		{name: "fischer without increment", s: "fischer:1m", want: TimeControl{Type: TimeControlFischer, Base: time.Minute}},
+		{name: "per move", s: "per_move:30s", want: TimeControl{Type: TimeControlPerMove, Base: 30 * time.Second}},
+		{name: "unknown type", s: "hourglass:1m", wantErr: true},
+		{name: "broken duration", s: "per_move:30", wantErr: true},
+		{name: "no base", s: "fischer:0s+3s", wantErr: true},
+		{name: "too long", s: "per_move:1h", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTimeControl(tt.s)
+			if tt.wantErr {
+				if !exceptions.IsInvalidArgumentError(err) {
+					t.Fatalf("wanted invalid argument error but got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to ParseTimeControl: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("wanted %+v but got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClock(t *testing.T) {
+	start := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	// newClockBattle tcの持ち時間でstartに対局を始めたbattleを返す.
+	newClockBattle := func(t *testing.T, r Rule, tc TimeControl) *Battle {
+		t.Helper()
+
+		b := r.OpenBattle(DefaultRuleSet())
+		for _, m := range []*Move{
+			NewOpen(DefaultRuleSet(), tc, false),
+			NewDeclaration("player_a"),
+			NewDeclaration("player_b"),
+		} {
+			m.PlayedAt = start
+			if err := Apply(r, b, m); err != nil {
+				t.Fatalf("failed to Apply: %v", err)
+			}
+		}
+		return b
+	}
+	play := func(t *testing.T, r Rule, b *Battle, m *Move, at time.Duration) error {
+		t.Helper()
+
+		m.PlayedAt = start.Add(at)
+		return Apply(r, b, m)
+	}
+
+	t.Run("fischer", func(t *testing.T) {
+		r := NewRule()
+		b := newClockBattle(t, r, TimeControl{Type: TimeControlFischer, Base: time.Minute, Increment: 5 * time.Second})
+
+		if err := play(t, r, b, NewAttack(playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L), 20*time.Second); err != nil {
+			t.Fatalf("failed to Apply: %v", err)
+		}
+		if got, want := b.Clock.PlayerARemaining, 45*time.Second; got != want {
+			t.Fatalf("wanted %s but got %s", want, got)
+		}
+		if got, want := b.Clock.Remaining(b, playerB, start.Add(30*time.Second)), 50*time.Second; got != want {
+			t.Fatalf("wanted %s but got %s", want, got)
+		}
+		if deadline, _ := b.Clock.Deadline(b); !deadline.Equal(start.Add(80 * time.Second)) {
+			t.Fatalf("unexpected deadline: %s", deadline)
+		}
+	})
+
+	t.Run("per move", func(t *testing.T) {
+		r := NewRule()
+		b := newClockBattle(t, r, TimeControl{Type: TimeControlPerMove, Base: 10 * time.Second})
+
+		if err := play(t, r, b, NewAttack(playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L), 9*time.Second); err != nil {
+			t.Fatalf("failed to Apply: %v", err)
+		}
+		if got := b.Clock.PlayerARemaining; got != 10*time.Second {
+			t.Fatalf("wanted remaining time to be reset but got %s", got)
+		}
+
+		err := play(t, r, b, NewAttack(playerB, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L), 19*time.Second)
+		if v, _ := AsViolationError(err); v.Violation != ViolationTimeExpired {
+			t.Fatalf("wanted %s but got %v", ViolationTimeExpired, err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		r := NewRule()
+		b := newClockBattle(t, r, TimeControl{Type: TimeControlPerMove, Base: 10 * time.Second})
+
+		err := play(t, r, b, NewTimeout(playerA), 9*time.Second)
+		if v, _ := AsViolationError(err); v.Violation != ViolationTimeRemaining {
+			t.Fatalf("wanted %s but got %v", ViolationTimeRemaining, err)
+		}
+		if err := play(t, r, b, NewTimeout(playerA), 10*time.Second); err != nil {
+			t.Fatalf("failed to Apply: %v", err)
+		}
+		if b.State != management_state.PlayerBWin {
+			t.Fatalf("wanted player b win but got %d", b.State)
+		}
+		if b.Clock.IsRunning() {
+			t.Fatalf("wanted the clock to stop")
+		}
+
+		if err := play(t, r, b, NewReset(b.RuleSet, b.TimeControl(), b.Ranked), 11*time.Second); err != nil {
+			t.Fatalf("failed to Apply: %v", err)
+		}
+		if got := b.Clock.PlayerARemaining; got != 10*time.Second {
+			t.Fatalf("wanted remaining time to be reset but got %s", got)
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package battle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/random"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+)
+
+const (
+	gameIDSuffixLength = 8
+)
+
+type (
+	// GameID is
+	//   room内の1ゲーム(開始からResetまで)の識別子. roomが削除された後もReplayの取得に使用する.
+	GameID string
+)
+
+func NewGameID(roomID room.ID) GameID {
+	return GameID(fmt.Sprintf("%s-%s", roomID, random.RandString6(gameIDSuffixLength)))
+}
+
+// RoomID ゲームが行われたroomのID. room.IDは数字のみのため最初の"-"で区切る.
+func (id GameID) RoomID() room.ID {
+	return room.ID(strings.SplitN(string(id), "-", 2)[0])
+}
+
+func (id GameID) String() string {
+	return string(id)
+}
@@ -0,0 +1,161 @@
+package battle
+
+import (
+	"sort"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+)
+
+const (
+	// gobbletWidth ModeGobbletの盤面の一辺のマス数.
+	gobbletWidth = 4
+	// gobbletSizes ModeGobbletの駒の大きさの種類数. 大きさは0(最小)から3(最大).
+	gobbletSizes = 4
+	// gobbletStacks ModeGobbletで1人が持つ外部の山の数.
+	gobbletStacks = 3
+	// GobbletReserve GobbletAction.Fromに指定すると外部の山から打つ.
+	GobbletReserve = -1
+)
+
+// gobbletLines ModeGobbletで揃うと勝ちになるpositionの組.
+var gobbletLines = lines(gobbletWidth)
+
+type (
+	// GobbletPiece ModeGobbletの盤上の駒.
+	GobbletPiece struct {
+		Owner tictactoe_battle.Player `json:"owner"`
+		Size  int                     `json:"size"`
+	}
+
+	// GobbletBoard is
+	//   ModeGobbletの盤面と外部の山.
+	//   山の駒は入れ子になっているため、一番上の駒の大きさは残りの数-1となる.
+	GobbletBoard struct {
+		// Cells positionごとに下から順に積まれた駒. positionは左上から右へ0,1,2...と数える.
+		Cells [][]GobbletPiece `json:"cells"`
+		// PlayerAStacks PlayerBStacks 外部の山ごとの残りの駒の数.
+		PlayerAStacks [gobbletStacks]int `json:"player_a_stacks"`
+		PlayerBStacks [gobbletStacks]int `json:"player_b_stacks"`
+		// WinLines 勝者の揃った全てのラインのposition.
+		WinLines [][]int `json:"win_lines,omitempty"`
+	}
+
+	// GobbletAction is
+	//   ModeGobbletの1手番の着手. FromがGobbletReserveの場合はStackの山から打ち、それ以外は盤上の駒を動かす.
+	GobbletAction struct {
+		Stack int `json:"stack"`
+		From  int `json:"from"`
+		To    int `json:"to"`
+	}
+)
+
+func NewGobbletPlace(stack, to int) GobbletAction {
+	return GobbletAction{Stack: stack, From: GobbletReserve, To: to}
+}
+
+func NewGobbletRelocation(from, to int) GobbletAction {
+	return GobbletAction{From: from, To: to}
+}
+
+func (a GobbletAction) IsRelocation() bool {
+	return a.From != GobbletReserve
+}
+
+func newGobbletBoard() *GobbletBoard {
+	g := &GobbletBoard{Cells: make([][]GobbletPiece, gobbletWidth*gobbletWidth)}
+	for i := 0; i < gobbletStacks; i++ {
+		g.PlayerAStacks[i] = gobbletSizes
+		g.PlayerBStacks[i] = gobbletSizes
+	}
+	return g
+}
+
+// Top posで一番上に見えている駒を返す. 駒が無い場合はfalse.
+func (g *GobbletBoard) Top(pos int) (GobbletPiece, bool) {
+	cell := g.Cells[pos]
+	if len(cell) == 0 {
+		return GobbletPiece{}, false
+	}
+	return cell[len(cell)-1], true
+}
+
+// OwnerAt posで一番上に見えている駒の持ち主を返す.
+func (g *GobbletBoard) OwnerAt(pos int) tictactoe_battle.Player {
+	top, ok := g.Top(pos)
+	if !ok {
+		return tictactoe_battle.Player_PLAYER_UNKNOWN
+	}
+	return top.Owner
+}
+
+func (g *GobbletBoard) stacksOf(player tictactoe_battle.Player) *[gobbletStacks]int {
+	if player == tictactoe_battle.Player_PLAYER_A {
+		return &g.PlayerAStacks
+	}
+	return &g.PlayerBStacks
+}
+
+// sortedStacks 外部の山の残りの数を昇順に返す. 山の並び順は局面に影響しない.
+func (g *GobbletBoard) sortedStacks(player tictactoe_battle.Player) []int {
+	stacks := *g.stacksOf(player)
+	ret := stacks[:]
+	sort.Ints(ret)
+	return ret
+}
+
+// inThreeInRow posの駒がownerの駒が3つ並んだラインに含まれるか.
+func (g *GobbletBoard) inThreeInRow(pos int, owner tictactoe_battle.Player) bool {
+	if g.OwnerAt(pos) != owner {
+		return false
+	}
+	for _, line := range gobbletLines {
+		contains, count := false, 0
+		for _, p := range line {
+			if p == pos {
+				contains = true
+			}
+			if g.OwnerAt(p) == owner {
+				count++
+			}
+		}
+		if contains && count >= gobbletWidth-1 {
+			return true
+		}
+	}
+	return false
+}
+
+// completedLines 一番上の駒が全てownerのラインを返す.
+func (g *GobbletBoard) completedLines(owner tictactoe_battle.Player) [][]int {
+	var ret [][]int
+	for _, line := range gobbletLines {
+		completed := true
+		for _, p := range line {
+			if g.OwnerAt(p) != owner {
+				completed = false
+				break
+			}
+		}
+		if completed {
+			ret = append(ret, line)
+		}
+	}
+	return ret
+}
+
+func (g *GobbletBoard) clone() *GobbletBoard {
+	ret := *g
+	ret.Cells = make([][]GobbletPiece, len(g.Cells))
+	for i, cell := range g.Cells {
+		if cell != nil {
+			ret.Cells[i] = append([]GobbletPiece{}, cell...)
+		}
+	}
+	if g.WinLines != nil {
+		ret.WinLines = make([][]int, len(g.WinLines))
+		for i, line := range g.WinLines {
+			ret.WinLines[i] = append([]int{}, line...)
+		}
+	}
+	return &ret
+}
@@ -0,0 +1,238 @@
+package battle
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+)
+
+func startGobblet(t *testing.T, r Rule) *Battle {
+	t.Helper()
+
+	b := r.OpenBattle(RuleSet{Mode: ModeGobblet})
+	for _, id := range []string{"player_a", "player_b"} {
+		if err := r.Declaration(b, id); err != nil {
+			t.Fatalf("failed to Declaration: %v", err)
+		}
+	}
+	return b
+}
+
+// put gの盤面に駒を直接積む.
+func put(g *GobbletBoard, owner tictactoe_battle.Player, size int, positions ...int) {
+	for _, pos := range positions {
+		g.Cells[pos] = append(g.Cells[pos], GobbletPiece{Owner: owner, Size: size})
+	}
+}
+
+func TestLines(t *testing.T) {
+	want := [][]int{
+		{0, 1, 2}, {3, 4, 5}, {6, 7, 8},
+		{0, 3, 6}, {1, 4, 7}, {2, 5, 8},
+		{0, 4, 8}, {2, 4, 6},
+	}
+	if diff := cmp.Diff(want, lines(3)); diff != "" {
+		t.Fatalf("(-want +got)\n%s", diff)
+	}
+	if got := len(gobbletLines); got != 10 {
+		t.Fatalf("wanted 10 lines but got %d", got)
+	}
+}
+
+func TestRule_PlayGobblet_Violation(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(g *GobbletBoard)
+		player tictactoe_battle.Player
+		action GobbletAction
+		want   Violation
+	}{
+		{
+			name:   "place on empty",
+			player: playerA,
+			action: NewGobbletPlace(0, 5),
+		},
+		{
+			name:   "not your turn",
+			player: playerB,
+			action: NewGobbletPlace(0, 5),
+			want:   ViolationNotYourTurn,
+		},
+		{
+			name:   "out of board",
+			player: playerA,
+			action: NewGobbletPlace(0, 16),
+			want:   ViolationInvalidPosition,
+		},
+		{
+			name:   "unknown stack",
+			player: playerA,
+			action: NewGobbletPlace(3, 5),
+			want:   ViolationInvalidPiece,
+		},
+		{
+			name:   "empty stack",
+			setup:  func(g *GobbletBoard) { g.PlayerAStacks[0] = 0 },
+			player: playerA,
+			action: NewGobbletPlace(0, 5),
+			want:   ViolationMissingPiece,
+		},
+		{
+			name:   "new piece on own piece",
+			setup:  func(g *GobbletBoard) { put(g, playerA, 0, 5) },
+			player: playerA,
+			action: NewGobbletPlace(0, 5),
+			want:   ViolationStackOnOwnPiece,
+		},
+		{
+			name:   "new piece on lone opponent piece",
+			setup:  func(g *GobbletBoard) { put(g, playerB, 0, 5) },
+			player: playerA,
+			action: NewGobbletPlace(0, 5),
+			want:   ViolationGobbleNotAllowed,
+		},
+		{
+			name:   "new piece blocks three in a row",
+			setup:  func(g *GobbletBoard) { put(g, playerB, 0, 4, 5, 6) },
+			player: playerA,
+			action: NewGobbletPlace(0, 5),
+		},
+		{
+			name: "new piece is not larger",
+			setup: func(g *GobbletBoard) {
+				put(g, playerB, 0, 4, 5, 6)
+				g.PlayerAStacks[0] = 1
+			},
+			player: playerA,
+			action: NewGobbletPlace(0, 5),
+			want:   ViolationLargerPiecePlaced,
+		},
+		{
+			name: "relocation gobbles any smaller piece",
+			setup: func(g *GobbletBoard) {
+				put(g, playerA, 3, 0)
+				put(g, playerB, 1, 5)
+			},
+			player: playerA,
+			action: NewGobbletRelocation(0, 5),
+		},
+		{
+			name: "relocation onto larger piece",
+			setup: func(g *GobbletBoard) {
+				put(g, playerA, 1, 0)
+				put(g, playerB, 2, 5)
+			},
+			player: playerA,
+			action: NewGobbletRelocation(0, 5),
+			want:   ViolationLargerPiecePlaced,
+		},
+		{
+			name:   "relocation of opponent piece",
+			setup:  func(g *GobbletBoard) { put(g, playerB, 3, 0) },
+			player: playerA,
+			action: NewGobbletRelocation(0, 5),
+			want:   ViolationNotOwnPiece,
+		},
+		{
+			name:   "relocation to same position",
+			setup:  func(g *GobbletBoard) { put(g, playerA, 3, 0) },
+			player: playerA,
+			action: NewGobbletRelocation(0, 0),
+			want:   ViolationSamePosition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRule()
+			b := startGobblet(t, r)
+			if tt.setup != nil {
+				tt.setup(b.Gobblet)
+			}
+
+			err := r.PlayGobblet(b, tt.player, tt.action)
+			v, _ := AsViolationError(err)
+			if v.Violation != tt.want {
+				t.Fatalf("wanted %q but got %v", tt.want, err)
+			}
+		})
+	}
+
+	t.Run("classic operations are rejected", func(t *testing.T) {
+		r := NewRule()
+		b := startGobblet(t, r)
+		err := r.Attack(b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L)
+		if v, _ := AsViolationError(err); v.Violation != ViolationUnsupportedMode {
+			t.Fatalf("wanted %s but got %v", ViolationUnsupportedMode, err)
+		}
+
+		err = r.PlayGobblet(startBattle(t, r), playerA, NewGobbletPlace(0, 0))
+		if v, _ := AsViolationError(err); v.Violation != ViolationUnsupportedMode {
+			t.Fatalf("wanted %s but got %v", ViolationUnsupportedMode, err)
+		}
+	})
+}
+
+func TestRule_PlayGobblet_Judgment(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(g *GobbletBoard)
+		action    GobbletAction
+		wantState management_state.State
+		wantLines [][]int
+	}{
+		{
+			name:      "four in a row",
+			setup:     func(g *GobbletBoard) { put(g, playerA, 3, 0, 1, 2) },
+			action:    NewGobbletPlace(0, 3),
+			wantState: management_state.PlayerAWin,
+			wantLines: [][]int{{0, 1, 2, 3}},
+		},
+		{
+			name: "anti diagonal",
+			setup: func(g *GobbletBoard) {
+				put(g, playerA, 3, 3, 6, 9)
+			},
+			action:    NewGobbletPlace(0, 12),
+			wantState: management_state.PlayerAWin,
+			wantLines: [][]int{{3, 6, 9, 12}},
+		},
+		{
+			name: "revealing the opponent line loses",
+			setup: func(g *GobbletBoard) {
+				put(g, playerB, 0, 0, 1, 2, 3)
+				put(g, playerA, 3, 3)
+			},
+			action:    NewGobbletRelocation(3, 8),
+			wantState: management_state.PlayerBWin,
+			wantLines: [][]int{{0, 1, 2, 3}},
+		},
+		{
+			name: "revealed line covered by the moved piece",
+			setup: func(g *GobbletBoard) {
+				put(g, playerB, 0, 0, 1, 2, 3)
+				put(g, playerA, 3, 3)
+			},
+			action:    NewGobbletRelocation(3, 0),
+			wantState: management_state.PlayerBTurn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRule()
+			b := startGobblet(t, r)
+			tt.setup(b.Gobblet)
+
+			if err := r.PlayGobblet(b, playerA, tt.action); err != nil {
+				t.Fatalf("failed to PlayGobblet: %v", err)
+			}
+			if b.State != tt.wantState {
+				t.Fatalf("wanted %d but got %d", tt.wantState, b.State)
+			}
+			if diff := cmp.Diff(tt.wantLines, b.Gobblet.WinLines); diff != "" {
+				t.Fatalf("(-want +got)\n%s", diff)
+			}
+		})
+	}
+}
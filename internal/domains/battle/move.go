@@ -0,0 +1,299 @@
+package battle
+
+import (
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
+	"golang.org/x/xerrors"
+)
+
+type (
+	MoveType int
+
+	// Move is
+	//   battleに対する1回の操作. 順にRuleへ適用することでbattleを再構築できる.
+	Move struct {
+		Type     MoveType                  `json:"type"`
+		GameID   GameID                    `json:"game_id,omitempty"`
+		PlayerID string                    `json:"player_id,omitempty"`
+		Player   tictactoe_battle.Player   `json:"player"`
+		Position tictactoe_battle.Position `json:"position"`
+		Piece    tictactoe_battle.Piece    `json:"piece"`
+		PlayedAt time.Time                 `json:"played_at"`
+		// Hash 適用後の局面のPositionHash.
+		Hash uint64 `json:"hash"`
+		// RuleSet MoveOpenとMoveResetで以降のゲームに適用するルール.
+		RuleSet *RuleSet `json:"rule_set,omitempty"`
+		// Gobblet MoveGobbletの着手.
+		Gobblet *GobbletAction `json:"gobblet,omitempty"`
+		// TimeControl MoveOpenとMoveResetで以降のゲームに適用する持ち時間.
+		TimeControl *TimeControl `json:"time_control,omitempty"`
+		// Rematch MoveRematchで始まるゲームの席と通算成績.
+		Rematch *Rematch `json:"rematch,omitempty"`
+		// Ratings MoveRatedで記録するratingの変化.
+		Ratings *rating.Result `json:"ratings,omitempty"`
+		// Ranked MoveOpen、MoveReset、MoveRematchで記録するroomのBattle.Ranked.
+		//   streamの古いmoveが削除されても、残っているゲームの先頭から再構築できるようにする.
+		Ranked bool `json:"ranked,omitempty"`
+	}
+)
+
+const (
+	MoveDeclaration MoveType = iota + 1
+	MovePick
+	MoveAttack
+	MoveReset
+	// MoveOpen roomの作成. streamの先頭に記録される.
+	MoveOpen
+	// MoveGobblet ModeGobbletの着手.
+	MoveGobblet
+	// MoveTimeout 手番のプレイヤーの持ち時間切れ.
+	MoveTimeout
+	MoveResign
+	MoveOfferDraw
+	MoveAcceptDraw
+	MoveDeclineDraw
+	// MoveResetRequest 対局中のResetの要求. 両者の要求が揃うとMoveResetが続く.
+	MoveResetRequest
+	MoveRematchRequest
+	// MoveRematch 席を入れ替えた再戦の開始. 新しいゲームの先頭に記録される.
+	MoveRematch
+	// MoveRated ranked roomで終了したゲームのratingの変化の記録. 終了後に続けて記録される.
+	MoveRated
+)
+
+func NewDeclaration(playerID string) *Move {
+	return &Move{
+		Type:     MoveDeclaration,
+		PlayerID: playerID,
+		Position: tictactoe_battle.Position_POSITION_UNDEFINED,
+	}
+}
+
+func NewPick(player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) *Move {
+	return &Move{
+		Type:     MovePick,
+		Player:   player,
+		Position: pos,
+		Piece:    size,
+	}
+}
+
+func NewAttack(player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) *Move {
+	return &Move{
+		Type:     MoveAttack,
+		Player:   player,
+		Position: pos,
+		Piece:    size,
+	}
+}
+
+func NewReset(rs RuleSet, tc TimeControl, ranked bool) *Move {
+	return &Move{
+		Type:        MoveReset,
+		Position:    tictactoe_battle.Position_POSITION_UNDEFINED,
+		RuleSet:     &rs,
+		TimeControl: &tc,
+		Ranked:      ranked,
+	}
+}
+
+func NewGobblet(player tictactoe_battle.Player, a GobbletAction) *Move {
+	return &Move{
+		Type:     MoveGobblet,
+		Player:   player,
+		Position: tictactoe_battle.Position_POSITION_UNDEFINED,
+		Gobblet:  &a,
+	}
+}
+
+func NewOpen(rs RuleSet, tc TimeControl, ranked bool) *Move {
+	return &Move{
+		Type:        MoveOpen,
+		Position:    tictactoe_battle.Position_POSITION_UNDEFINED,
+		RuleSet:     &rs,
+		TimeControl: &tc,
+		Ranked:      ranked,
+	}
+}
+
+func NewTimeout(player tictactoe_battle.Player) *Move {
+	return newPlayerMove(MoveTimeout, player)
+}
+
+func NewResign(player tictactoe_battle.Player) *Move {
+	return newPlayerMove(MoveResign, player)
+}
+
+func NewOfferDraw(player tictactoe_battle.Player) *Move {
+	return newPlayerMove(MoveOfferDraw, player)
+}
+
+func NewAcceptDraw(player tictactoe_battle.Player) *Move {
+	return newPlayerMove(MoveAcceptDraw, player)
+}
+
+func NewDeclineDraw(player tictactoe_battle.Player) *Move {
+	return newPlayerMove(MoveDeclineDraw, player)
+}
+
+func NewResetRequest(player tictactoe_battle.Player) *Move {
+	return newPlayerMove(MoveResetRequest, player)
+}
+
+func NewRematchRequest(player tictactoe_battle.Player) *Move {
+	return newPlayerMove(MoveRematchRequest, player)
+}
+
+func NewRematch(rs RuleSet, tc TimeControl, ranked bool, rm Rematch) *Move {
+	return &Move{
+		Type:        MoveRematch,
+		Position:    tictactoe_battle.Position_POSITION_UNDEFINED,
+		RuleSet:     &rs,
+		TimeControl: &tc,
+		Rematch:     &rm,
+		Ranked:      ranked,
+	}
+}
+
+func NewRated(result rating.Result) *Move {
+	return &Move{
+		Type:     MoveRated,
+		Position: tictactoe_battle.Position_POSITION_UNDEFINED,
+		Ratings:  &result,
+	}
+}
+
+// newPlayerMove 盤面の位置を伴わないplayerの操作.
+func newPlayerMove(t MoveType, player tictactoe_battle.Player) *Move {
+	return &Move{
+		Type:     t,
+		Player:   player,
+		Position: tictactoe_battle.Position_POSITION_UNDEFINED,
+	}
+}
+
+// Apply moveをruleに従ってbattleに適用する. 持ち時間はmoveのPlayedAtの時刻で消費する.
+func Apply(r Rule, b *Battle, m *Move) error {
+	mover := b.Turn()
+	finished := b.State.IsFinished()
+	switch m.Type {
+	case MovePick, MoveAttack, MoveGobblet, MoveResign, MoveOfferDraw, MoveAcceptDraw, MoveDeclineDraw:
+		if b.Clock.Expired(b, m.PlayedAt) {
+			return newViolationError(ViolationTimeExpired, "%s has run out of time", mover)
+		}
+	}
+
+	switch m.Type {
+	case MoveDeclaration:
+		if err := r.Declaration(b, m.PlayerID); err != nil {
+			return xerrors.Errorf("failed to Declaration: %w", err)
+		}
+	case MovePick:
+		if err := r.Pick(b, m.Player, m.Position, m.Piece); err != nil {
+			return xerrors.Errorf("failed to Pick: %w", err)
+		}
+	case MoveAttack:
+		if err := r.Attack(b, m.Player, m.Position, m.Piece); err != nil {
+			return xerrors.Errorf("failed to Attack: %w", err)
+		}
+	case MoveGobblet:
+		if m.Gobblet == nil {
+			return xerrors.New("gobblet move has no action")
+		}
+		if err := r.PlayGobblet(b, m.Player, *m.Gobblet); err != nil {
+			return xerrors.Errorf("failed to PlayGobblet: %w", err)
+		}
+	case MoveResign:
+		if err := r.Resign(b, m.Player); err != nil {
+			return xerrors.Errorf("failed to Resign: %w", err)
+		}
+	case MoveOfferDraw:
+		if err := r.OfferDraw(b, m.Player); err != nil {
+			return xerrors.Errorf("failed to OfferDraw: %w", err)
+		}
+	case MoveAcceptDraw:
+		if err := r.AcceptDraw(b, m.Player); err != nil {
+			return xerrors.Errorf("failed to AcceptDraw: %w", err)
+		}
+	case MoveDeclineDraw:
+		if err := r.DeclineDraw(b, m.Player); err != nil {
+			return xerrors.Errorf("failed to DeclineDraw: %w", err)
+		}
+	case MoveResetRequest:
+		if err := r.RequestReset(b, m.Player); err != nil {
+			return xerrors.Errorf("failed to RequestReset: %w", err)
+		}
+	case MoveRematchRequest:
+		if err := r.RequestRematch(b, m.Player); err != nil {
+			return xerrors.Errorf("failed to RequestRematch: %w", err)
+		}
+	case MoveTimeout:
+		if err := r.Timeout(b, m.Player, m.PlayedAt); err != nil {
+			return xerrors.Errorf("failed to Timeout: %w", err)
+		}
+	case MoveReset, MoveOpen, MoveRematch:
+		if m.Type == MoveRematch && m.Rematch == nil {
+			return xerrors.New("rematch move has no players")
+		}
+		// RuleSet、TimeControlが記録されていないmoveは直前のルールを引き継ぐ
+		if m.RuleSet != nil {
+			b.RuleSet = *m.RuleSet
+		}
+		if m.TimeControl != nil {
+			b.Clock = NewClock(*m.TimeControl)
+		}
+		b.Ranked = m.Ranked
+		if m.Type != MoveRematch {
+			r.Reset(b)
+			return nil
+		}
+		r.Rematch(b, *m.Rematch)
+	case MoveRated:
+		if m.Ratings == nil {
+			return xerrors.New("rated move has no ratings")
+		}
+		if !finished || b.Ratings != nil {
+			return newViolationError(ViolationNotFinished, "battle is not finished or already rated: %d", b.State)
+		}
+		ratings := *m.Ratings
+		b.Ratings = &ratings
+	default:
+		return xerrors.Errorf("unexpected move type: %d", m.Type)
+	}
+
+	if !finished && b.State.IsFinished() {
+		b.Series.record(b)
+	}
+	// 時間切れの場合はTimeoutで時計を止めている
+	if b.Clock != nil && m.Type != MoveTimeout {
+		b.Clock.advance(b, mover, m.PlayedAt, m.Type == MovePick || m.Type == MoveAttack || m.Type == MoveGobblet)
+	}
+
+	return nil
+}
+
+// StartsGame 新しいゲームを開始するmoveか. このmoveから後だけを適用してもbattleを再構築できる.
+func (m *Move) StartsGame() bool {
+	switch m.Type {
+	case MoveOpen, MoveReset, MoveRematch:
+		return true
+	default:
+		return false
+	}
+}
+
+// Replay 開始直後のbattleにmovesを順に適用して再構築する.
+func Replay(r Rule, moves []*Move) (*Battle, error) {
+	b := r.OpenBattle(DefaultRuleSet())
+	for i, m := range moves {
+		if err := Apply(r, b, m); err != nil {
+			return nil, xerrors.Errorf("failed to Apply move %d: %w", i, err)
+		}
+		if m.Hash != 0 && m.Hash != PositionHash(b) {
+			return nil, xerrors.Errorf("position hash mismatch at move %d", i)
+		}
+	}
+	return b, nil
+}
@@ -0,0 +1,107 @@
+package battle
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+)
+
+func TestReplay(t *testing.T) {
+	r := NewRule()
+	b := r.OpenBattle(DefaultRuleSet())
+
+	var moves []*Move
+	for _, m := range []*Move{
+		NewDeclaration("player_a"),
+		NewDeclaration("player_b"),
+		NewAttack(playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L),
+		NewAttack(playerB, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_M),
+		NewPick(playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L),
+		NewAttack(playerA, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L),
+	} {
+		if err := Apply(r, b, m); err != nil {
+			t.Fatalf("failed to Apply: %v", err)
+		}
+		m.Hash = PositionHash(b)
+		moves = append(moves, m)
+	}
+
+	replayed, err := Replay(r, moves)
+	if err != nil {
+		t.Fatalf("failed to Replay: %v", err)
+	}
+	if got, want := PositionHash(replayed), PositionHash(b); got != want {
+		t.Fatalf("wanted hash %d but got %d", want, got)
+	}
+	if replayed.State != b.State || replayed.MoveCount != b.MoveCount {
+		t.Fatalf("wanted (%d, %d) but got (%d, %d)", b.State, b.MoveCount, replayed.State, replayed.MoveCount)
+	}
+	if diff := cmp.Diff(b.Repetitions, replayed.Repetitions); diff != "" {
+		t.Fatalf(diff)
+	}
+
+	moves[len(moves)-1].Hash++
+	if _, err := Replay(r, moves); err == nil {
+		t.Fatalf("wanted hash mismatch error")
+	}
+}
+
+func TestReplay_RuleSet(t *testing.T) {
+	r := NewRule()
+	rs := ruleSetPresets["tictactoe"]
+	moves := []*Move{
+		NewOpen(rs, TimeControl{}, false),
+		NewDeclaration("player_a"),
+		NewDeclaration("player_b"),
+		NewAttack(playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L),
+	}
+
+	replayed, err := Replay(r, moves)
+	if err != nil {
+		t.Fatalf("failed to Replay: %v", err)
+	}
+	if replayed.RuleSet != rs {
+		t.Fatalf("wanted %+v but got %+v", rs, replayed.RuleSet)
+	}
+	if got := replayed.PlayerAHolding.L; got != 4 {
+		t.Fatalf("wanted 4 large pieces but got %d", got)
+	}
+}
+
+func TestReplay_FromGameStart(t *testing.T) {
+	r := NewRule()
+	rs := ruleSetPresets["tictactoe"]
+	moves := []*Move{
+		NewOpen(rs, TimeControl{}, true),
+		NewDeclaration("player_a"),
+		NewDeclaration("player_b"),
+		NewAttack(playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L),
+		NewReset(rs, TimeControl{}, true),
+		NewDeclaration("player_b"),
+		NewDeclaration("player_a"),
+		NewAttack(playerA, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L),
+	}
+	if !moves[4].StartsGame() || moves[3].StartsGame() {
+		t.Fatalf("unexpected StartsGame")
+	}
+
+	whole, err := Replay(r, moves)
+	if err != nil {
+		t.Fatalf("failed to Replay: %v", err)
+	}
+	// streamの先頭のゲームが削除された場合も同じbattleになる
+	replayed, err := Replay(r, moves[4:])
+	if err != nil {
+		t.Fatalf("failed to Replay: %v", err)
+	}
+	if !replayed.Ranked || replayed.RuleSet != rs {
+		t.Fatalf("wanted ranked %+v but got %t, %+v", rs, replayed.Ranked, replayed.RuleSet)
+	}
+	if got, want := PositionHash(replayed), PositionHash(whole); got != want {
+		t.Fatalf("wanted hash %d but got %d", want, got)
+	}
+	if replayed.State != whole.State || replayed.PlayerAID != whole.PlayerAID {
+		t.Fatalf("wanted (%d, %s) but got (%d, %s)", whole.State, whole.PlayerAID, replayed.State, replayed.PlayerAID)
+	}
+}
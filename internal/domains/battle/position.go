@@ -0,0 +1,173 @@
+package battle
+
+import (
+	"math"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+)
+
+const (
+	// SymmetryCount 盤面の回転と反転の組み合わせの数.
+	SymmetryCount = 8
+)
+
+// zobrist乱数の種別.
+const (
+	zobristStack = iota + 1
+	zobristHolding
+	zobristPicked
+	zobristSide
+	zobristGobbletCell
+	zobristGobbletStack
+)
+
+// symmetries fieldの大きさごとのSymmetriesのcache.
+var symmetries = map[int][][]int{
+	9:  newSymmetries(9),
+	16: newSymmetries(16),
+}
+
+// PositionHash 盤面、両者の持ち駒、持ち上げている駒、手番から局面を識別するZobrist hashを返す.
+//   ModeGobbletでは4x4の盤面と外部の山も含める.
+//   乱数は要素ごとに固定で決まるため、processやversionをまたいでも同じ局面は同じ値となる.
+func PositionHash(b *Battle) uint64 {
+	return positionHash(b, nil)
+}
+
+// CanonicalHash 盤面を回転、反転した8通りの局面で最小のPositionHashを返す.
+//   対称な局面は全て同じ値となる.
+func CanonicalHash(b *Battle) uint64 {
+	ret := uint64(math.MaxUint64)
+	for _, perm := range Symmetries(b.fieldSize()) {
+		if h := positionHash(b, perm); h < ret {
+			ret = h
+		}
+	}
+	return ret
+}
+
+// Symmetries 正方形のfieldを回転、反転した8通りについて、各positionの移動先のindexを返す.
+//   先頭は恒等変換. 返り値は変更しないこと.
+func Symmetries(fieldSize int) [][]int {
+	if s, ok := symmetries[fieldSize]; ok {
+		return s
+	}
+	return newSymmetries(fieldSize)
+}
+
+// lines 一辺がwidthの正方形のfieldで、揃うと勝ちになるpositionの組を行、列、斜めの順に返す.
+func lines(width int) [][]int {
+	ret := make([][]int, 0, 2*width+2)
+	for y := 0; y < width; y++ {
+		row := make([]int, width)
+		for x := range row {
+			row[x] = y*width + x
+		}
+		ret = append(ret, row)
+	}
+	for x := 0; x < width; x++ {
+		col := make([]int, width)
+		for y := range col {
+			col[y] = y*width + x
+		}
+		ret = append(ret, col)
+	}
+	diag, anti := make([]int, width), make([]int, width)
+	for i := 0; i < width; i++ {
+		diag[i] = i*width + i
+		anti[i] = i*width + width - 1 - i
+	}
+	return append(ret, diag, anti)
+}
+
+func newSymmetries(fieldSize int) [][]int {
+	width := int(math.Sqrt(float64(fieldSize)))
+	ret := make([][]int, 0, SymmetryCount)
+	for _, reflect := range []bool{false, true} {
+		for rotation := 0; rotation < 4; rotation++ {
+			perm := make([]int, fieldSize)
+			for i := range perm {
+				x, y := i%width, i/width
+				if reflect {
+					x = width - 1 - x
+				}
+				for r := 0; r < rotation; r++ {
+					x, y = width-1-y, x
+				}
+				perm[i] = y*width + x
+			}
+			ret = append(ret, perm)
+		}
+	}
+	return ret
+}
+
+// positionHash permでpositionを移した局面のPositionHash. permがnilの場合は移さない.
+func positionHash(b *Battle, perm []int) uint64 {
+	moved := func(pos int) uint64 {
+		if perm == nil {
+			return uint64(pos)
+		}
+		return uint64(perm[pos])
+	}
+
+	var h uint64
+	for i, stack := range b.Field {
+		for size, owner := range [3]tictactoe_battle.Player{stack.S, stack.M, stack.L} {
+			if owner != tictactoe_battle.Player_PLAYER_UNKNOWN {
+				h ^= zobrist(zobristStack, moved(i), uint64(size), uint64(owner))
+			}
+		}
+	}
+	for _, holding := range []struct {
+		player  tictactoe_battle.Player
+		holding *tictactoe_battle.Holding
+	}{
+		{tictactoe_battle.Player_PLAYER_A, b.PlayerAHolding},
+		{tictactoe_battle.Player_PLAYER_B, b.PlayerBHolding},
+	} {
+		for size, n := range [3]uint64{holding.holding.S, holding.holding.M, holding.holding.L} {
+			h ^= zobrist(zobristHolding, uint64(holding.player), uint64(size), n)
+		}
+	}
+	if b.PickedPosition != tictactoe_battle.Position_POSITION_UNDEFINED {
+		h ^= zobrist(zobristPicked, moved(int(b.PickedPosition)), uint64(b.PickedPiece), 0)
+	}
+	if g := b.Gobblet; g != nil {
+		for i, cell := range g.Cells {
+			for depth, p := range cell {
+				h ^= zobrist(zobristGobbletCell, moved(i), uint64(depth)<<8|uint64(p.Size), uint64(p.Owner))
+			}
+		}
+		for _, player := range []tictactoe_battle.Player{tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Player_PLAYER_B} {
+			for i, n := range g.sortedStacks(player) {
+				h ^= zobrist(zobristGobbletStack, uint64(player), uint64(i), uint64(n))
+			}
+		}
+	}
+	h ^= zobrist(zobristSide, uint64(sideToMove(b.State)), 0, 0)
+
+	return h
+}
+
+// zobrist 要素ごとの乱数. 要素の値をsplitmix64で撹拌して求める.
+func zobrist(kind, a, b, c uint64) uint64 {
+	x := kind<<48 ^ a<<32 ^ b<<16 ^ c
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ x>>30) * 0xbf58476d1ce4e5b9
+	x = (x ^ x>>27) * 0x94d049bb133111eb
+	return x ^ x>>31
+}
+
+func sideToMove(s management_state.State) tictactoe_battle.Player {
+	switch s {
+	// 引き分けの提案中は回答する側の手番とする
+	case management_state.PlayerATurn, management_state.PlayerAPicked, management_state.PlayerBDrawOffered:
+		return tictactoe_battle.Player_PLAYER_A
+	case management_state.PlayerBTurn, management_state.PlayerBPicked, management_state.PlayerADrawOffered:
+		return tictactoe_battle.Player_PLAYER_B
+	default:
+		return tictactoe_battle.Player_PLAYER_UNKNOWN
+	}
+}
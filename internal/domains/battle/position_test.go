@@ -0,0 +1,65 @@
+package battle
+
+import (
+	"testing"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+)
+
+// transform bの盤面をpermで移した局面を返す.
+func transform(b *Battle, perm []int) *Battle {
+	ret := b.Clone()
+	for i, stack := range b.Field {
+		ret.Field[perm[i]] = &tictactoe_battle.PieceStack{S: stack.S, M: stack.M, L: stack.L}
+	}
+	if b.PickedPosition != tictactoe_battle.Position_POSITION_UNDEFINED {
+		ret.PickedPosition = tictactoe_battle.Position(perm[b.PickedPosition])
+	}
+	return ret
+}
+
+func TestCanonicalHash(t *testing.T) {
+	r := NewRule()
+
+	// A - -
+	// - B -
+	// - - -  Aが(0,0)のLを持ち上げ中
+	b := startBattle(t, r)
+	attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L)
+	attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_M)
+	attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X1Y0, tictactoe_battle.Piece_PIECE_S)
+	attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X2Y2, tictactoe_battle.Piece_PIECE_S)
+	if err := r.Pick(b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L); err != nil {
+		t.Fatalf("failed to Pick: %v", err)
+	}
+
+	want := CanonicalHash(b)
+	seen := make(map[uint64]struct{})
+	for _, perm := range Symmetries(len(b.Field)) {
+		moved := transform(b, perm)
+		if got := CanonicalHash(moved); got != want {
+			t.Fatalf("wanted %x but got %x by %v", want, got, perm)
+		}
+		seen[PositionHash(moved)] = struct{}{}
+	}
+	if len(seen) != SymmetryCount {
+		t.Fatalf("wanted %d distinct position hashes but got %d", SymmetryCount, len(seen))
+	}
+
+	t.Run("differs by picked position", func(t *testing.T) {
+		c := b.Clone()
+		c.PickedPosition = tictactoe_battle.Position_POSITION_X2Y0
+		if CanonicalHash(c) == want {
+			t.Fatalf("different positions must not share the hash")
+		}
+	})
+
+	t.Run("differs by side to move", func(t *testing.T) {
+		c := b.Clone()
+		c.State = management_state.PlayerBPicked
+		if CanonicalHash(c) == want {
+			t.Fatalf("different sides to move must not share the hash")
+		}
+	})
+}
@@ -2,44 +2,113 @@
 package battle
 
 import (
+	"time"
+
 	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
-	"golang.org/x/xerrors"
 )
 
 type (
+	// Rule is
+	//   battleに対する操作. エラーを返す操作はbattleのコピーで検証と適用を行い、成功した場合のみ反映する.
 	Rule interface {
-		OpenBattle() *Battle
+		// OpenBattle rsのルールで対局者を待つbattleを返す.
+		OpenBattle(rs RuleSet) *Battle
 		Declaration(b *Battle, playerID string) error
 		Attack(b *Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) error
 		Pick(b *Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) error
+		// PlayGobblet ModeGobbletのbattleでaを着手する.
+		PlayGobblet(b *Battle, player tictactoe_battle.Player, a GobbletAction) error
+		// Timeout nowの時点で手番のplayerの持ち時間が切れていれば負けとする.
+		Timeout(b *Battle, player tictactoe_battle.Player, now time.Time) error
+		// Resign playerが投了し、相手の勝ちとする. 対局中であれば手番に関わらず投了できる.
+		Resign(b *Battle, player tictactoe_battle.Player) error
+		// OfferDraw 手番のplayerが引き分けを提案する. 相手が回答するまで両者とも着手できない.
+		OfferDraw(b *Battle, player tictactoe_battle.Player) error
+		// AcceptDraw playerが相手の提案に応じて引き分けとする.
+		AcceptDraw(b *Battle, player tictactoe_battle.Player) error
+		// DeclineDraw playerが相手の提案を断り、提案した側の手番に戻す.
+		DeclineDraw(b *Battle, player tictactoe_battle.Player) error
+		// RequestReset 対局中にplayerがResetを要求したことを記録する. 両者が要求した場合にResetできる.
+		RequestReset(b *Battle, player tictactoe_battle.Player) error
+		// RequestRematch ゲームの終了後にplayerが同じ2人での再戦を要求する.
+		RequestRematch(b *Battle, player tictactoe_battle.Player) error
+		// AcceptRematch playerが相手の再戦の要求に応じられるか検証し、席を入れ替えた次のゲームを返す. bは変更しない.
+		AcceptRematch(b *Battle, player tictactoe_battle.Player) (Rematch, error)
+		// Rematch rmの2人が席についた状態で新しいゲームを始める.
+		Rematch(b *Battle, rm Rematch)
+		// LegalMoves playerが現在の局面で選べる全ての着手を返す. 手番でない場合は空.
+		LegalMoves(b *Battle, player tictactoe_battle.Player) []Action
 		Reset(b *Battle)
 	}
 
 	rule struct{}
 )
 
+type winLine struct {
+	line      tictactoe_battle.WinLine
+	positions [3]tictactoe_battle.Position
+}
+
+// winLines WinLineごとのfieldのposition. 盤面の形から行、列、斜めの順に生成する.
+//   0 1 2
+//   3 4 5
+//   6 7 8
+var winLines = newWinLines()
+
+// pieces 小さい順の駒の大きさ.
+var pieces = []tictactoe_battle.Piece{
+	tictactoe_battle.Piece_PIECE_S,
+	tictactoe_battle.Piece_PIECE_M,
+	tictactoe_battle.Piece_PIECE_L,
+}
+
+// Lines 揃うと勝ちになるpositionの組を全て返す.
+func Lines() [][3]tictactoe_battle.Position {
+	ret := make([][3]tictactoe_battle.Position, 0, len(winLines))
+	for _, wl := range winLines {
+		ret = append(ret, wl.positions)
+	}
+	return ret
+}
+
+const (
+	// repetitionLimit 同一局面がこの回数出現した場合は引き分けとする.
+	repetitionLimit = 3
+)
+
 func NewRule() Rule {
 	return &rule{}
 }
 
-func (r *rule) OpenBattle() *Battle {
-	return &Battle{
+func (r *rule) OpenBattle(rs RuleSet) *Battle {
+	b := &Battle{
 		RoomID:         "",
+		RuleSet:        rs,
 		PlayerAID:      "",
-		PlayerAHolding: newDefaultHolding(),
+		PlayerAHolding: rs.newHolding(),
 		PlayerBID:      "",
-		PlayerBHolding: newDefaultHolding(),
+		PlayerBHolding: rs.newHolding(),
 		State:          management_state.Meeting,
 		PickedPosition: tictactoe_battle.Position_POSITION_UNDEFINED,
 		PickedPiece:    tictactoe_battle.Piece_PIECE_UNKNOWN,
 		Field:          newDefaultFiled(),
 		WinLine:        tictactoe_battle.WinLine_WIN_LINE_UNKNOWN,
 	}
+	if rs.IsGobblet() {
+		b.Gobblet = newGobbletBoard()
+	}
+	return b
 }
 
 func (r *rule) Declaration(b *Battle, playerID string) error {
+	return commit(b, func(c *Battle) error {
+		return r.declaration(c, playerID)
+	})
+}
+
+func (r *rule) declaration(b *Battle, playerID string) error {
 	// 切断後の再接続を考慮
 	if b.PlayerAID == playerID || b.PlayerBID == playerID {
 		return nil
@@ -53,6 +122,7 @@ func (r *rule) Declaration(b *Battle, playerID string) error {
 	if b.PlayerBID == "" {
 		b.PlayerBID = playerID
 		b.State = management_state.PlayerATurn
+		r.judgeDraw(b)
 		return nil
 	}
 
@@ -60,6 +130,64 @@ func (r *rule) Declaration(b *Battle, playerID string) error {
 }
 
 func (r *rule) Attack(b *Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) error {
+	return commit(b, func(c *Battle) error {
+		return r.attack(c, player, pos, size)
+	})
+}
+
+func (r *rule) attack(b *Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) error {
+	if err := r.checkAttack(b, player, pos, size); err != nil {
+		return err
+	}
+
+	// put piece
+	holding := holdingOf(b, player)
+	stack := b.Field[pos]
+	switch size {
+	case tictactoe_battle.Piece_PIECE_S:
+		stack.S = player
+		holding.S--
+	case tictactoe_battle.Piece_PIECE_M:
+		stack.M = player
+		holding.M--
+	case tictactoe_battle.Piece_PIECE_L:
+		stack.L = player
+		holding.L--
+	}
+
+	// reset picked state
+	b.PickedPosition = tictactoe_battle.Position_POSITION_UNDEFINED
+	b.PickedPiece = tictactoe_battle.Piece_PIECE_UNKNOWN
+
+	r.judgment(b, player)
+	r.endTurn(b)
+
+	return nil
+}
+
+// endTurn 保留中のReset要求を取り消し、勝敗が決まっていなければ手番を交代し、引き分けを判定する.
+func (r *rule) endTurn(b *Battle) {
+	b.ResetRequestedBy = tictactoe_battle.Player_PLAYER_UNKNOWN
+
+	switch b.State {
+	case management_state.PlayerAWin, management_state.PlayerBWin:
+		return
+	case management_state.PlayerATurn, management_state.PlayerAPicked:
+		b.State = management_state.PlayerBTurn
+	case management_state.PlayerBTurn, management_state.PlayerBPicked:
+		b.State = management_state.PlayerATurn
+	}
+
+	b.MoveCount++
+	r.judgeDraw(b)
+}
+
+// checkAttack Attackが可能か検証する. bは変更しない.
+func (r *rule) checkAttack(b *Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) error {
+	if b.Gobblet != nil {
+		return newViolationError(ViolationUnsupportedMode, "use PlayGobblet in gobblet mode")
+	}
+
 	// check turn
 	var valid bool
 	switch b.State {
@@ -71,254 +199,676 @@ func (r *rule) Attack(b *Battle, player tictactoe_battle.Player, pos tictactoe_b
 		// invalid
 	}
 	if !valid {
-		return xerrors.Errorf("it is not %s's turn: %s", player, b.State)
+		return newViolationError(ViolationNotYourTurn, "it is not %s's turn: %d", player, b.State)
 	}
 
 	// check dest position
-	if pos == tictactoe_battle.Position_POSITION_UNDEFINED {
-		return xerrors.Errorf("selected unexpected position: %s", pos)
+	if !b.onField(pos) {
+		return newViolationError(ViolationInvalidPosition, "selected unexpected position: %s", pos)
 	}
 
 	// check placement in picked field
 	if b.PickedPosition == pos {
-		return xerrors.New("cannot be relocated to the field from which it was picked")
+		return newViolationError(ViolationSamePosition, "cannot be relocated to the field from which it was picked")
 	}
 
 	// check placement from picked size
 	if b.PickedPiece != tictactoe_battle.Piece_PIECE_UNKNOWN && b.PickedPiece != size {
-		return xerrors.New("only the selected piece can be rearranged")
+		return newViolationError(ViolationNotPickedPiece, "only the selected piece can be rearranged")
 	}
 
-	// check stack & put piece
+	// check stack
 	const (
 		MissingPiecesMsg = "missing pieces on holding: %s"
 		sizeInvalidMsg   = "pieces larger than the specified size have been placed. pos: %s, size: %s"
 		stackInvalidMsg  = "cannot stack it on own piece"
 	)
 
-	var holding *tictactoe_battle.Holding
-	switch player {
-	case tictactoe_battle.Player_PLAYER_A:
-		holding = b.PlayerAHolding
-	default:
-		holding = b.PlayerBHolding
-	}
-
+	holding := holdingOf(b, player)
 	stack := b.Field[pos]
+	ownGobble := b.RuleSet.normalize().OwnGobble
 	switch size {
 	case tictactoe_battle.Piece_PIECE_S:
 		if holding.S <= 0 {
-			return xerrors.Errorf(MissingPiecesMsg, size)
+			return newViolationError(ViolationMissingPiece, MissingPiecesMsg, size)
 		}
 		if stack.L != tictactoe_battle.Player_PLAYER_UNKNOWN ||
 			stack.M != tictactoe_battle.Player_PLAYER_UNKNOWN ||
 			stack.S != tictactoe_battle.Player_PLAYER_UNKNOWN {
-			return xerrors.Errorf(sizeInvalidMsg, pos, size)
+			return newViolationError(ViolationLargerPiecePlaced, sizeInvalidMsg, pos, size)
 		}
 
-		stack.S = player
-		holding.S--
-
 	case tictactoe_battle.Piece_PIECE_M:
 		if holding.M <= 0 {
-			return xerrors.Errorf(MissingPiecesMsg, size)
+			return newViolationError(ViolationMissingPiece, MissingPiecesMsg, size)
 		}
 		if stack.L != tictactoe_battle.Player_PLAYER_UNKNOWN ||
 			stack.M != tictactoe_battle.Player_PLAYER_UNKNOWN {
-			return xerrors.Errorf(sizeInvalidMsg, pos, size)
+			return newViolationError(ViolationLargerPiecePlaced, sizeInvalidMsg, pos, size)
 		}
-		if stack.S == player {
-			return xerrors.New(stackInvalidMsg)
+		if !ownGobble && stack.S == player {
+			return newViolationError(ViolationStackOnOwnPiece, stackInvalidMsg)
 		}
 
-		stack.M = player
-		holding.M--
-
 	case tictactoe_battle.Piece_PIECE_L:
 		if holding.L <= 0 {
-			return xerrors.Errorf(MissingPiecesMsg, size)
+			return newViolationError(ViolationMissingPiece, MissingPiecesMsg, size)
 		}
 		if stack.L != tictactoe_battle.Player_PLAYER_UNKNOWN {
-			return xerrors.Errorf(sizeInvalidMsg, pos, size)
+			return newViolationError(ViolationLargerPiecePlaced, sizeInvalidMsg, pos, size)
 		}
-		if stack.M == player ||
-			stack.M == tictactoe_battle.Player_PLAYER_UNKNOWN && stack.S == player {
-			return xerrors.New(stackInvalidMsg)
+		if !ownGobble && (stack.M == player ||
+			stack.M == tictactoe_battle.Player_PLAYER_UNKNOWN && stack.S == player) {
+			return newViolationError(ViolationStackOnOwnPiece, stackInvalidMsg)
 		}
 
-		stack.L = player
-		holding.L--
+	default:
+		return newViolationError(ViolationInvalidPiece, "selected unexpected piece: %s", size)
 	}
 
-	// reset picked state
-	b.PickedPosition = tictactoe_battle.Position_POSITION_UNDEFINED
-	b.PickedPiece = tictactoe_battle.Piece_PIECE_UNKNOWN
+	return nil
+}
 
-	r.judgment(b)
+func (r *rule) Pick(b *Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) error {
+	return commit(b, func(c *Battle) error {
+		return r.pick(c, player, pos, size)
+	})
+}
 
-	// turn change
-	switch b.State {
-	case management_state.PlayerAWin, management_state.PlayerBWin:
-		return nil
-	case management_state.PlayerATurn, management_state.PlayerAPicked:
-		b.State = management_state.PlayerBTurn
-	case management_state.PlayerBTurn, management_state.PlayerBPicked:
-		b.State = management_state.PlayerATurn
+func (r *rule) pick(b *Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) error {
+	if err := r.checkPick(b, player, pos, size); err != nil {
+		return err
+	}
+
+	if player == tictactoe_battle.Player_PLAYER_A {
+		b.State = management_state.PlayerAPicked
+	} else {
+		b.State = management_state.PlayerBPicked
 	}
 
+	// pick
+	holding := holdingOf(b, player)
+	stack := b.Field[pos]
+	switch size {
+	case tictactoe_battle.Piece_PIECE_S:
+		stack.S = tictactoe_battle.Player_PLAYER_UNKNOWN
+		holding.S++
+	case tictactoe_battle.Piece_PIECE_M:
+		stack.M = tictactoe_battle.Player_PLAYER_UNKNOWN
+		holding.M++
+	case tictactoe_battle.Piece_PIECE_L:
+		stack.L = tictactoe_battle.Player_PLAYER_UNKNOWN
+		holding.L++
+	}
+
+	b.PickedPosition = pos
+	b.PickedPiece = size
+
+	r.judgment(b, player)
+
 	return nil
 }
 
-func (r *rule) Pick(b *Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) error {
+// checkPick Pickが可能か検証する. bは変更しない.
+func (r *rule) checkPick(b *Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) error {
+	if b.Gobblet != nil {
+		return newViolationError(ViolationUnsupportedMode, "use PlayGobblet in gobblet mode")
+	}
+
+	if b.PickedPosition != tictactoe_battle.Position_POSITION_UNDEFINED && b.Turn() == player {
+		return newViolationError(ViolationAlreadyPicked, "a piece has already been picked from %s", b.PickedPosition)
+	}
+
 	var valid bool
 	switch b.State {
 	case management_state.PlayerATurn:
 		valid = player == tictactoe_battle.Player_PLAYER_A
-		b.State = management_state.PlayerAPicked
 	case management_state.PlayerBTurn:
 		valid = player == tictactoe_battle.Player_PLAYER_B
-		b.State = management_state.PlayerBPicked
 	default:
 		// invalid
 	}
 	if !valid {
-		return xerrors.Errorf("it is not %s's turn: %s", player, b.State)
+		return newViolationError(ViolationNotYourTurn, "it is not %s's turn: %d", player, b.State)
 	}
 
-	// check stack & pick
+	if !b.RuleSet.normalize().Relocation {
+		return newViolationError(ViolationRelocationDisabled, "relocation is disabled in this rule set")
+	}
+
+	if !b.onField(pos) {
+		return newViolationError(ViolationInvalidPosition, "selected unexpected position: %s", pos)
+	}
+
+	// check stack
 	const (
 		largerPiecesMsg  = "larger pieces are placed. pos: %s"
 		playerInvalidMsg = "it's not the player's piece. player: %s"
 	)
 	stack := b.Field[pos]
-	var holding *tictactoe_battle.Holding
-	switch player {
-	case tictactoe_battle.Player_PLAYER_A:
-		holding = b.PlayerAHolding
-	default:
-		holding = b.PlayerBHolding
-	}
-
 	switch size {
 	case tictactoe_battle.Piece_PIECE_S:
 		if stack.L != tictactoe_battle.Player_PLAYER_UNKNOWN ||
 			stack.M != tictactoe_battle.Player_PLAYER_UNKNOWN {
-			return xerrors.Errorf(largerPiecesMsg, pos)
+			return newViolationError(ViolationLargerPiecePlaced, largerPiecesMsg, pos)
 		}
 		if player != stack.S {
-			return xerrors.Errorf(playerInvalidMsg, stack.S)
+			return newViolationError(ViolationNotOwnPiece, playerInvalidMsg, stack.S)
 		}
 
-		stack.S = tictactoe_battle.Player_PLAYER_UNKNOWN
-		holding.S++
-
 	case tictactoe_battle.Piece_PIECE_M:
 		if stack.L != tictactoe_battle.Player_PLAYER_UNKNOWN {
-			return xerrors.Errorf(largerPiecesMsg, pos)
+			return newViolationError(ViolationLargerPiecePlaced, largerPiecesMsg, pos)
 		}
 		if player != stack.M {
-			return xerrors.Errorf(playerInvalidMsg, stack.M)
+			return newViolationError(ViolationNotOwnPiece, playerInvalidMsg, stack.M)
 		}
 
-		stack.M = tictactoe_battle.Player_PLAYER_UNKNOWN
-		holding.M++
-
 	case tictactoe_battle.Piece_PIECE_L:
 		if player != stack.L {
-			return xerrors.Errorf(playerInvalidMsg, stack.L)
+			return newViolationError(ViolationNotOwnPiece, playerInvalidMsg, stack.L)
 		}
 
-		stack.L = tictactoe_battle.Player_PLAYER_UNKNOWN
-		holding.L++
+	default:
+		return newViolationError(ViolationInvalidPiece, "selected unexpected piece: %s", size)
 	}
 
-	b.PickedPosition = pos
-	b.PickedPiece = size
+	return nil
+}
+
+func (r *rule) PlayGobblet(b *Battle, player tictactoe_battle.Player, a GobbletAction) error {
+	return commit(b, func(c *Battle) error {
+		return r.playGobblet(c, player, a)
+	})
+}
+
+func (r *rule) playGobblet(b *Battle, player tictactoe_battle.Player, a GobbletAction) error {
+	if err := r.checkGobblet(b, player, a); err != nil {
+		return err
+	}
+
+	g := b.Gobblet
+	var piece GobbletPiece
+	if a.IsRelocation() {
+		cell := g.Cells[a.From]
+		piece = cell[len(cell)-1]
+		g.Cells[a.From] = cell[:len(cell)-1]
+	} else {
+		stacks := g.stacksOf(player)
+		piece = GobbletPiece{Owner: player, Size: stacks[a.Stack] - 1}
+		stacks[a.Stack]--
+	}
+	g.Cells[a.To] = append(g.Cells[a.To], piece)
+
+	r.judgeGobblet(b, player)
+	r.endTurn(b)
+
+	return nil
+}
+
+func (r *rule) Timeout(b *Battle, player tictactoe_battle.Player, now time.Time) error {
+	return commit(b, func(c *Battle) error {
+		return r.timeout(c, player, now)
+	})
+}
 
-	r.judgment(b)
+func (r *rule) timeout(b *Battle, player tictactoe_battle.Player, now time.Time) error {
+	if b.Turn() != player {
+		return newViolationError(ViolationNotYourTurn, "it is not %s's turn: %d", player, b.State)
+	}
+	if !b.Clock.Expired(b, now) {
+		return newViolationError(ViolationTimeRemaining, "%s still has time", player)
+	}
+
+	b.Clock.flag(player)
+	b.PickedPosition = tictactoe_battle.Position_POSITION_UNDEFINED
+	b.PickedPiece = tictactoe_battle.Piece_PIECE_UNKNOWN
+	setWinner(b, opponentOf(player))
 
 	return nil
 }
 
+func (r *rule) Resign(b *Battle, player tictactoe_battle.Player) error {
+	return commit(b, func(c *Battle) error {
+		return r.resign(c, player)
+	})
+}
+
+func (r *rule) resign(b *Battle, player tictactoe_battle.Player) error {
+	if err := checkInProgress(b, player); err != nil {
+		return err
+	}
+
+	b.PickedPosition = tictactoe_battle.Position_POSITION_UNDEFINED
+	b.PickedPiece = tictactoe_battle.Piece_PIECE_UNKNOWN
+	if player == tictactoe_battle.Player_PLAYER_A {
+		b.State = management_state.PlayerAResigned
+	} else {
+		b.State = management_state.PlayerBResigned
+	}
+
+	return nil
+}
+
+func (r *rule) OfferDraw(b *Battle, player tictactoe_battle.Player) error {
+	return commit(b, func(c *Battle) error {
+		return r.offerDraw(c, player)
+	})
+}
+
+func (r *rule) offerDraw(b *Battle, player tictactoe_battle.Player) error {
+	// 駒を持ち上げている間は提案できない
+	switch {
+	case b.State == management_state.PlayerATurn && player == tictactoe_battle.Player_PLAYER_A:
+		b.State = management_state.PlayerADrawOffered
+	case b.State == management_state.PlayerBTurn && player == tictactoe_battle.Player_PLAYER_B:
+		b.State = management_state.PlayerBDrawOffered
+	default:
+		return newViolationError(ViolationNotYourTurn, "it is not %s's turn: %d", player, b.State)
+	}
+
+	return nil
+}
+
+func (r *rule) AcceptDraw(b *Battle, player tictactoe_battle.Player) error {
+	return commit(b, func(c *Battle) error {
+		return r.acceptDraw(c, player)
+	})
+}
+
+func (r *rule) acceptDraw(b *Battle, player tictactoe_battle.Player) error {
+	if err := checkDrawOffer(b, player); err != nil {
+		return err
+	}
+
+	b.State = management_state.DrawAgreed
+	return nil
+}
+
+func (r *rule) DeclineDraw(b *Battle, player tictactoe_battle.Player) error {
+	return commit(b, func(c *Battle) error {
+		return r.declineDraw(c, player)
+	})
+}
+
+func (r *rule) declineDraw(b *Battle, player tictactoe_battle.Player) error {
+	if err := checkDrawOffer(b, player); err != nil {
+		return err
+	}
+
+	if b.State == management_state.PlayerADrawOffered {
+		b.State = management_state.PlayerATurn
+	} else {
+		b.State = management_state.PlayerBTurn
+	}
+	return nil
+}
+
+func (r *rule) RequestReset(b *Battle, player tictactoe_battle.Player) error {
+	return commit(b, func(c *Battle) error {
+		return r.requestReset(c, player)
+	})
+}
+
+func (r *rule) requestReset(b *Battle, player tictactoe_battle.Player) error {
+	if err := checkInProgress(b, player); err != nil {
+		return err
+	}
+
+	b.ResetRequestedBy = player
+	return nil
+}
+
+func (r *rule) RequestRematch(b *Battle, player tictactoe_battle.Player) error {
+	return commit(b, func(c *Battle) error {
+		return r.requestRematch(c, player)
+	})
+}
+
+func (r *rule) requestRematch(b *Battle, player tictactoe_battle.Player) error {
+	if err := checkFinished(b, player); err != nil {
+		return err
+	}
+
+	b.RematchRequestedBy = player
+	return nil
+}
+
+func (r *rule) AcceptRematch(b *Battle, player tictactoe_battle.Player) (Rematch, error) {
+	if err := checkFinished(b, player); err != nil {
+		return Rematch{}, err
+	}
+	if b.RematchRequestedBy != opponentOf(player) {
+		return Rematch{}, newViolationError(ViolationNoRematchRequest, "no rematch is requested to %s", player)
+	}
+
+	return Rematch{
+		PlayerAID: b.PlayerBID,
+		PlayerBID: b.PlayerAID,
+		Series:    b.Series.swapped(),
+	}, nil
+}
+
+func (r *rule) Rematch(b *Battle, rm Rematch) {
+	r.Reset(b)
+	b.PlayerAID = rm.PlayerAID
+	b.PlayerBID = rm.PlayerBID
+	b.Series = rm.Series
+	b.State = management_state.PlayerATurn
+	r.judgeDraw(b)
+}
+
+// checkFinished playerが席についているゲームが終了しているか検証する.
+func checkFinished(b *Battle, player tictactoe_battle.Player) error {
+	if player != tictactoe_battle.Player_PLAYER_A && player != tictactoe_battle.Player_PLAYER_B {
+		return newViolationError(ViolationNotFinished, "%s is not a player", player)
+	}
+	if !b.State.IsFinished() {
+		return newViolationError(ViolationNotFinished, "battle is not finished: %d", b.State)
+	}
+	return nil
+}
+
+// checkInProgress playerが席についている対局が進行中か検証する.
+func checkInProgress(b *Battle, player tictactoe_battle.Player) error {
+	if player != tictactoe_battle.Player_PLAYER_A && player != tictactoe_battle.Player_PLAYER_B {
+		return newViolationError(ViolationNotInProgress, "%s is not a player", player)
+	}
+	if b.Turn() == tictactoe_battle.Player_PLAYER_UNKNOWN {
+		return newViolationError(ViolationNotInProgress, "battle is not in progress: %d", b.State)
+	}
+	return nil
+}
+
+// checkDrawOffer playerが相手から引き分けを提案されているか検証する.
+func checkDrawOffer(b *Battle, player tictactoe_battle.Player) error {
+	if !b.State.IsDrawOffered() || b.Turn() != player {
+		return newViolationError(ViolationNoDrawOffer, "no draw is offered to %s: %d", player, b.State)
+	}
+	return nil
+}
+
+// checkGobblet PlayGobbletが可能か検証する. bは変更しない.
+func (r *rule) checkGobblet(b *Battle, player tictactoe_battle.Player, a GobbletAction) error {
+	g := b.Gobblet
+	if g == nil {
+		return newViolationError(ViolationUnsupportedMode, "PlayGobblet is only available in gobblet mode")
+	}
+
+	// 引き分けの提案中は着手できない
+	if b.Turn() != player || b.State.IsDrawOffered() {
+		return newViolationError(ViolationNotYourTurn, "it is not %s's turn: %d", player, b.State)
+	}
+
+	if a.To < 0 || a.To >= len(g.Cells) {
+		return newViolationError(ViolationInvalidPosition, "selected unexpected position: %d", a.To)
+	}
+
+	var size int
+	if a.IsRelocation() {
+		if a.From < 0 || a.From >= len(g.Cells) {
+			return newViolationError(ViolationInvalidPosition, "selected unexpected position: %d", a.From)
+		}
+		if a.From == a.To {
+			return newViolationError(ViolationSamePosition, "cannot be relocated to the field from which it was picked")
+		}
+		top, ok := g.Top(a.From)
+		if !ok || top.Owner != player {
+			return newViolationError(ViolationNotOwnPiece, "it's not the player's piece. pos: %d", a.From)
+		}
+		size = top.Size
+	} else {
+		if a.Stack < 0 || a.Stack >= gobbletStacks {
+			return newViolationError(ViolationInvalidPiece, "selected unexpected stack: %d", a.Stack)
+		}
+		n := g.stacksOf(player)[a.Stack]
+		if n <= 0 {
+			return newViolationError(ViolationMissingPiece, "stack %d is empty", a.Stack)
+		}
+		size = n - 1
+	}
+
+	target, ok := g.Top(a.To)
+	if !ok {
+		return nil
+	}
+	if target.Size >= size {
+		return newViolationError(ViolationLargerPiecePlaced, "pieces larger than the selected piece have been placed. pos: %d", a.To)
+	}
+
+	// 外部の山の駒は、相手が3つ並べたラインの駒を塞ぐ場合に限り盤上の駒に被せられる
+	if !a.IsRelocation() {
+		if target.Owner == player {
+			return newViolationError(ViolationStackOnOwnPiece, "cannot stack a new piece on own piece")
+		}
+		if !g.inThreeInRow(a.To, target.Owner) {
+			return newViolationError(ViolationGobbleNotAllowed, "a new piece can only gobble a piece in three in a row. pos: %d", a.To)
+		}
+	}
+
+	return nil
+}
+
+// commit bのコピーにfnを適用し、成功した場合のみbに反映する. fnがエラーを返した場合はbを変更しない.
+func commit(b *Battle, fn func(c *Battle) error) error {
+	c := b.Clone()
+	if err := fn(c); err != nil {
+		return err
+	}
+
+	*b = *c
+	return nil
+}
+
+func (r *rule) LegalMoves(b *Battle, player tictactoe_battle.Player) []Action {
+	var actions []Action
+	r.eachLegalMove(b, player, func(a Action) bool {
+		actions = append(actions, a)
+		return true
+	})
+	return actions
+}
+
+// eachLegalMove playerの合法手を順にyieldへ渡す. yieldがfalseを返した時点で列挙を打ち切る.
+func (r *rule) eachLegalMove(b *Battle, player tictactoe_battle.Player, yield func(Action) bool) {
+	// 持ち上げ中の駒は元の位置以外へ置く
+	if b.PickedPosition != tictactoe_battle.Position_POSITION_UNDEFINED {
+		for to := range b.Field {
+			if r.checkAttack(b, player, tictactoe_battle.Position(to), b.PickedPiece) == nil {
+				if !yield(Action{From: b.PickedPosition, To: tictactoe_battle.Position(to), Piece: b.PickedPiece}) {
+					return
+				}
+			}
+		}
+		return
+	}
+
+	for _, size := range pieces {
+		for to := range b.Field {
+			if r.checkAttack(b, player, tictactoe_battle.Position(to), size) == nil {
+				if !yield(Action{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position(to), Piece: size}) {
+					return
+				}
+			}
+		}
+	}
+
+	for i := range b.Field {
+		from := tictactoe_battle.Position(i)
+		size := topPiece(b.Field[from])
+		if r.checkPick(b, player, from, size) != nil {
+			continue
+		}
+
+		picked := b.Clone()
+		_ = r.pick(picked, player, from, size)
+		if picked.State.IsFinished() {
+			// 持ち上げた時点で相手のラインが現れる場合は置き場所を選べない
+			if !yield(Action{From: from, To: tictactoe_battle.Position_POSITION_UNDEFINED, Piece: size}) {
+				return
+			}
+			continue
+		}
+		for to := range picked.Field {
+			if r.checkAttack(picked, player, tictactoe_battle.Position(to), size) == nil {
+				if !yield(Action{From: from, To: tictactoe_battle.Position(to), Piece: size}) {
+					return
+				}
+			}
+		}
+	}
+}
+
 func (r *rule) Reset(b *Battle) {
 	b.State = management_state.Meeting
 	b.PlayerAID = ""
 	b.PlayerBID = ""
-	b.PlayerAHolding = newDefaultHolding()
-	b.PlayerBHolding = newDefaultHolding()
+	b.PlayerAHolding = b.RuleSet.newHolding()
+	b.PlayerBHolding = b.RuleSet.newHolding()
 	b.Field = newDefaultFiled()
 	b.PickedPosition = tictactoe_battle.Position_POSITION_UNDEFINED
 	b.PickedPiece = tictactoe_battle.Piece_PIECE_UNKNOWN
 	b.WinLine = tictactoe_battle.WinLine_WIN_LINE_UNKNOWN
+	b.WinLines = nil
+	b.MoveCount = 0
+	b.Repetitions = nil
+	b.Gobblet = nil
+	if b.RuleSet.IsGobblet() {
+		b.Gobblet = newGobbletBoard()
+	}
+	if b.Clock != nil {
+		b.Clock = NewClock(b.Clock.TimeControl)
+	}
+	b.ResetRequestedBy = tictactoe_battle.Player_PLAYER_UNKNOWN
+	b.Series = Series{}
+	b.RematchRequestedBy = tictactoe_battle.Player_PLAYER_UNKNOWN
+	b.Ratings = nil
 }
 
-func (r *rule) judgment(b *Battle) {
-	field := [][]tictactoe_battle.Player{
-		{stackOwner(b.Field[0]), stackOwner(b.Field[3]), stackOwner(b.Field[6])},
-		{stackOwner(b.Field[1]), stackOwner(b.Field[4]), stackOwner(b.Field[7])},
-		{stackOwner(b.Field[2]), stackOwner(b.Field[5]), stackOwner(b.Field[8])},
+// judgeDraw 現在の局面を記録し、同一局面の出現回数または手数が上限に達した場合は引き分けとする.
+//   手番のプレイヤーが着手できない場合も引き分けとする.
+func (r *rule) judgeDraw(b *Battle) {
+	if b.Repetitions == nil {
+		b.Repetitions = make(map[uint64]int)
 	}
 
-	winPlayer := func(player tictactoe_battle.Player) management_state.State {
-		if player == tictactoe_battle.Player_PLAYER_A {
-			return management_state.PlayerAWin
-		}
-		return management_state.PlayerBWin
+	hash := PositionHash(b)
+	b.Repetitions[hash]++
+
+	if b.Repetitions[hash] >= repetitionLimit || (b.RuleSet.MaxMoves > 0 && b.MoveCount >= b.RuleSet.MaxMoves) || !r.hasLegalMove(b, b.Turn()) {
+		b.State = management_state.Draw
 	}
+}
 
-	if target := field[0][0]; target != tictactoe_battle.Player_PLAYER_UNKNOWN {
-		if target == field[1][0] && target == field[2][0] {
-			b.WinLine = tictactoe_battle.WinLine_WIN_LINE_1
-			b.State = winPlayer(target)
-			return
-		}
-		if target == field[0][1] && target == field[0][2] {
-			b.WinLine = tictactoe_battle.WinLine_WIN_LINE_4
-			b.State = winPlayer(target)
-			return
+// hasLegalMove playerが着手できるか. LegalMovesと同じ列挙を最初の1手が見つかった時点で打ち切る.
+func (r *rule) hasLegalMove(b *Battle, player tictactoe_battle.Player) bool {
+	// ModeGobbletでは最大の駒を覆う駒が無いため、常に何らかの着手ができる
+	if b.Gobblet != nil {
+		return true
+	}
+
+	found := false
+	r.eachLegalMove(b, player, func(Action) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// judgeGobblet ModeGobbletで両プレイヤーの揃ったラインを集め、judgmentと同様に勝者を決定する.
+//   駒を動かして相手のラインが現れた場合も、移動先で塞いでいれば負けとならない.
+func (r *rule) judgeGobblet(b *Battle, mover tictactoe_battle.Player) {
+	g := b.Gobblet
+	opponent := opponentOf(mover)
+	lines := g.completedLines(opponent)
+	winner := opponent
+	if len(lines) == 0 {
+		lines = g.completedLines(mover)
+		winner = mover
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	g.WinLines = lines
+	setWinner(b, winner)
+}
+
+// judgment 両プレイヤーの揃ったラインを全て集め、勝者を決定する.
+//   公式ルールに従い、手番側が駒を持ち上げて相手のラインが現れた場合は、
+//   手番側のラインが揃っていても相手の勝ちとする.
+func (r *rule) judgment(b *Battle, mover tictactoe_battle.Player) {
+	lines := make(map[tictactoe_battle.Player][]tictactoe_battle.WinLine)
+	for _, wl := range winLines {
+		owner := stackOwner(b.Field[wl.positions[0]])
+		if owner == tictactoe_battle.Player_PLAYER_UNKNOWN {
+			continue
 		}
-		if target == field[1][1] && target == field[2][2] {
-			b.WinLine = tictactoe_battle.WinLine_WIN_LINE_7
-			b.State = winPlayer(target)
-			return
+		if owner == stackOwner(b.Field[wl.positions[1]]) && owner == stackOwner(b.Field[wl.positions[2]]) {
+			lines[owner] = append(lines[owner], wl.line)
 		}
 	}
 
-	if target := field[0][1]; target != tictactoe_battle.Player_PLAYER_UNKNOWN {
-		if target == field[1][1] && target == field[2][1] {
-			b.WinLine = tictactoe_battle.WinLine_WIN_LINE_2
-			b.State = winPlayer(target)
-			return
-		}
+	var winner tictactoe_battle.Player
+	switch opponent := opponentOf(mover); {
+	case len(lines[opponent]) > 0:
+		winner = opponent
+	case len(lines[mover]) > 0:
+		winner = mover
+	default:
+		return
 	}
 
-	if target := field[0][2]; target != tictactoe_battle.Player_PLAYER_UNKNOWN {
-		if target == field[1][2] && target == field[2][2] {
-			b.WinLine = tictactoe_battle.WinLine_WIN_LINE_3
-			b.State = winPlayer(target)
-			return
-		}
+	b.WinLines = lines[winner]
+	b.WinLine = lines[winner][0]
+	setWinner(b, winner)
+}
+
+func setWinner(b *Battle, winner tictactoe_battle.Player) {
+	if winner == tictactoe_battle.Player_PLAYER_A {
+		b.State = management_state.PlayerAWin
+	} else {
+		b.State = management_state.PlayerBWin
 	}
+}
 
-	if target := field[1][0]; target != tictactoe_battle.Player_PLAYER_UNKNOWN {
-		if target == field[1][1] && target == field[1][2] {
-			b.WinLine = tictactoe_battle.WinLine_WIN_LINE_5
-			b.State = winPlayer(target)
-			return
+func newWinLines() []winLine {
+	ret := make([]winLine, 0, 8)
+	for i, line := range lines(3) {
+		wl := winLine{line: tictactoe_battle.WinLine(i + 1)}
+		for j, pos := range line {
+			wl.positions[j] = tictactoe_battle.Position(pos)
 		}
+		ret = append(ret, wl)
 	}
+	return ret
+}
 
-	if target := field[2][0]; target != tictactoe_battle.Player_PLAYER_UNKNOWN {
-		if target == field[2][1] && target == field[2][2] {
-			b.WinLine = tictactoe_battle.WinLine_WIN_LINE_6
-			b.State = winPlayer(target)
-			return
-		}
-		if target == field[1][1] && target == field[0][2] {
-			b.WinLine = tictactoe_battle.WinLine_WIN_LINE_8
-			b.State = winPlayer(target)
-			return
-		}
+func opponentOf(player tictactoe_battle.Player) tictactoe_battle.Player {
+	if player == tictactoe_battle.Player_PLAYER_A {
+		return tictactoe_battle.Player_PLAYER_B
+	}
+	return tictactoe_battle.Player_PLAYER_A
+}
+
+func holdingOf(b *Battle, player tictactoe_battle.Player) *tictactoe_battle.Holding {
+	if player == tictactoe_battle.Player_PLAYER_A {
+		return b.PlayerAHolding
+	}
+	return b.PlayerBHolding
+}
+
+// topPiece stackで一番上に見えている駒の大きさを返す.
+func topPiece(s *tictactoe_battle.PieceStack) tictactoe_battle.Piece {
+	switch {
+	case s.L != tictactoe_battle.Player_PLAYER_UNKNOWN:
+		return tictactoe_battle.Piece_PIECE_L
+	case s.M != tictactoe_battle.Player_PLAYER_UNKNOWN:
+		return tictactoe_battle.Piece_PIECE_M
+	case s.S != tictactoe_battle.Player_PLAYER_UNKNOWN:
+		return tictactoe_battle.Piece_PIECE_S
+	default:
+		return tictactoe_battle.Piece_PIECE_UNKNOWN
 	}
 }
 
@@ -336,14 +886,6 @@ func stackOwner(s *tictactoe_battle.PieceStack) tictactoe_battle.Player {
 	return tictactoe_battle.Player_PLAYER_UNKNOWN
 }
 
-func newDefaultHolding() *tictactoe_battle.Holding {
-	return &tictactoe_battle.Holding{
-		S: 2,
-		M: 2,
-		L: 2,
-	}
-}
-
 func newDefaultFiled() []*tictactoe_battle.PieceStack {
 	ret := make([]*tictactoe_battle.PieceStack, 9)
 	for i := 0; i < 9; i++ {
@@ -0,0 +1,166 @@
+package battle
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+)
+
+const (
+	// maxPieces 1種類の駒の最大数. 盤面のマス数を超えて持つ意味は無い.
+	maxPieces = 9
+	// minPieces 勝つために必要な駒の数.
+	minPieces = 3
+)
+
+// ゲームの種類.
+const (
+	// ModeGobblers 3x3の盤面で大中小の駒を使うGobblet Gobblers.
+	ModeGobblers Mode = "gobblers"
+	// ModeGobblet 4x4の盤面で4種類の大きさの駒を3つの山から使うGobblet.
+	ModeGobblet Mode = "gobblet"
+)
+
+type (
+	// Mode is
+	//   ゲームの種類. 空の場合はModeGobblersとして扱う.
+	Mode string
+
+	// RuleSet is
+	//   roomごとに選べるルール. ゼロ値はDefaultRuleSetとして扱う.
+	//   ModeGobbletではMaxMoves以外の項目は使用せず、ゼロ値でなければならない.
+	RuleSet struct {
+		// Mode ゲームの種類.
+		Mode Mode `json:"mode,omitempty"`
+		// Sizes 使用する駒の大きさの種類数. 大きい方から使用する.
+		Sizes int `json:"sizes"`
+		// Pieces 小中大それぞれの持ち駒の数. 使用しない大きさは0.
+		Pieces [3]uint64 `json:"pieces"`
+		// OwnGobble 自分の駒に被せられるか.
+		OwnGobble bool `json:"own_gobble"`
+		// Relocation 盤上の駒を動かせるか.
+		Relocation bool `json:"relocation"`
+		// MaxMoves 手数がこの数に達した場合に引き分けとする. 0の場合は制限しない.
+		MaxMoves int `json:"max_moves,omitempty"`
+	}
+)
+
+// ruleSetPresets 名前で選べるRuleSet.
+var ruleSetPresets = map[string]RuleSet{
+	"default": DefaultRuleSet(),
+	"tictactoe": {
+		Mode:   ModeGobblers,
+		Sizes:  1,
+		Pieces: [3]uint64{0, 0, 5},
+	},
+	"own_gobble": {
+		Mode:       ModeGobblers,
+		Sizes:      3,
+		Pieces:     [3]uint64{2, 2, 2},
+		OwnGobble:  true,
+		Relocation: true,
+	},
+	"gobblet": {Mode: ModeGobblet},
+}
+
+// DefaultRuleSet 大中小2個ずつの駒を使い、盤上の駒も動かせる標準のルール.
+func DefaultRuleSet() RuleSet {
+	return RuleSet{
+		Mode:       ModeGobblers,
+		Sizes:      3,
+		Pieces:     [3]uint64{2, 2, 2},
+		Relocation: true,
+	}
+}
+
+// ParseRuleSet presetの名前またはJSONからRuleSetを返す.
+func ParseRuleSet(s string) (RuleSet, error) {
+	if strings.HasPrefix(strings.TrimSpace(s), "{") {
+		var rs RuleSet
+		if err := json.Unmarshal([]byte(s), &rs); err != nil {
+			return RuleSet{}, exceptions.NewInvalidArgumentError(fmt.Sprintf("invalid rule set: %s", s))
+		}
+		if err := rs.Validate(); err != nil {
+			return RuleSet{}, err
+		}
+		return rs, nil
+	}
+
+	rs, ok := ruleSetPresets[strings.ToLower(s)]
+	if !ok {
+		return RuleSet{}, exceptions.NewInvalidArgumentError(fmt.Sprintf("unknown rule set: %s", s))
+	}
+	return rs, nil
+}
+
+// Validate 対局が成立するRuleSetか検証する.
+func (rs RuleSet) Validate() error {
+	if rs.MaxMoves < 0 {
+		return exceptions.NewInvalidArgumentError(fmt.Sprintf("max moves must not be negative: %d", rs.MaxMoves))
+	}
+
+	switch rs.Mode {
+	case "", ModeGobblers:
+	case ModeGobblet:
+		if rs != (RuleSet{Mode: ModeGobblet, MaxMoves: rs.MaxMoves}) {
+			return exceptions.NewInvalidArgumentError("gobblet mode does not accept rule variants")
+		}
+		return nil
+	default:
+		return exceptions.NewInvalidArgumentError(fmt.Sprintf("unknown mode: %s", rs.Mode))
+	}
+
+	if rs.Sizes < 1 || rs.Sizes > len(rs.Pieces) {
+		return exceptions.NewInvalidArgumentError(fmt.Sprintf("sizes must be between 1 and %d: %d", len(rs.Pieces), rs.Sizes))
+	}
+
+	var total uint64
+	for i, n := range rs.Pieces {
+		used := i >= len(rs.Pieces)-rs.Sizes
+		switch {
+		case used && (n == 0 || n > maxPieces):
+			return exceptions.NewInvalidArgumentError(fmt.Sprintf("pieces of %s must be between 1 and %d: %d", pieces[i], maxPieces, n))
+		case !used && n != 0:
+			return exceptions.NewInvalidArgumentError(fmt.Sprintf("%s is not used but has %d pieces", pieces[i], n))
+		}
+		total += n
+	}
+	if total < minPieces {
+		return exceptions.NewInvalidArgumentError(fmt.Sprintf("at least %d pieces are required: %d", minPieces, total))
+	}
+
+	return nil
+}
+
+// IsDefault DefaultRuleSetと同じルールか.
+func (rs RuleSet) IsDefault() bool {
+	return rs.normalize() == DefaultRuleSet()
+}
+
+// IsGobblet 4x4のModeGobbletか.
+func (rs RuleSet) IsGobblet() bool {
+	return rs.Mode == ModeGobblet
+}
+
+func (rs RuleSet) normalize() RuleSet {
+	switch {
+	case rs.IsGobblet():
+	case rs.Sizes == 0:
+		return DefaultRuleSet()
+	case rs.Mode == "":
+		rs.Mode = ModeGobblers
+	}
+	return rs
+}
+
+func (rs RuleSet) newHolding() *tictactoe_battle.Holding {
+	rs = rs.normalize()
+	return &tictactoe_battle.Holding{
+		S: rs.Pieces[0],
+		M: rs.Pieces[1],
+		L: rs.Pieces[2],
+	}
+}
@@ -0,0 +1,58 @@
+package battle
+
+import (
+	"testing"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+)
+
+func TestParseRuleSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    RuleSet
+		wantErr bool
+	}{
+		{name: "preset", s: "tictactoe", want: RuleSet{Mode: ModeGobblers, Sizes: 1, Pieces: [3]uint64{0, 0, 5}}},
+		{name: "gobblet", s: "gobblet", want: RuleSet{Mode: ModeGobblet}},
+		{name: "preset is case insensitive", s: "Default", want: DefaultRuleSet()},
+		{
+			name: "json",
+			s:    `{"sizes":2,"pieces":[0,3,3],"own_gobble":true}`,
+			want: RuleSet{Sizes: 2, Pieces: [3]uint64{0, 3, 3}, OwnGobble: true},
+		},
+		{
+			name: "max moves",
+			s:    `{"sizes":3,"pieces":[2,2,2],"relocation":true,"max_moves":40}`,
+			want: RuleSet{Sizes: 3, Pieces: [3]uint64{2, 2, 2}, Relocation: true, MaxMoves: 40},
+		},
+		{name: "gobblet with max moves", s: `{"mode":"gobblet","max_moves":60}`, want: RuleSet{Mode: ModeGobblet, MaxMoves: 60}},
+		{name: "unknown preset", s: "chess", wantErr: true},
+		{name: "negative max moves", s: `{"sizes":1,"pieces":[0,0,5],"max_moves":-1}`, wantErr: true},
+		{name: "unknown mode", s: `{"mode":"chess"}`, wantErr: true},
+		{name: "gobblet with variants", s: `{"mode":"gobblet","own_gobble":true}`, wantErr: true},
+		{name: "broken json", s: `{"sizes":`, wantErr: true},
+		{name: "no sizes", s: `{"sizes":0,"pieces":[2,2,2]}`, wantErr: true},
+		{name: "unused size has pieces", s: `{"sizes":2,"pieces":[1,2,2]}`, wantErr: true},
+		{name: "used size has no pieces", s: `{"sizes":2,"pieces":[0,0,3]}`, wantErr: true},
+		{name: "too many pieces", s: `{"sizes":1,"pieces":[0,0,10]}`, wantErr: true},
+		{name: "not enough pieces to win", s: `{"sizes":1,"pieces":[0,0,2]}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRuleSet(tt.s)
+			if tt.wantErr {
+				if !exceptions.IsInvalidArgumentError(err) {
+					t.Fatalf("wanted invalid argument error but got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to ParseRuleSet: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("wanted %+v but got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,579 @@
+package battle
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+const (
+	playerA = tictactoe_battle.Player_PLAYER_A
+	playerB = tictactoe_battle.Player_PLAYER_B
+)
+
+func startBattle(t *testing.T, r Rule) *Battle {
+	t.Helper()
+
+	return startBattleWith(t, r, DefaultRuleSet())
+}
+
+func startBattleWith(t *testing.T, r Rule, rs RuleSet) *Battle {
+	t.Helper()
+
+	b := r.OpenBattle(rs)
+	if err := r.Declaration(b, "player_a"); err != nil {
+		t.Fatalf("failed to Declaration: %v", err)
+	}
+	if err := r.Declaration(b, "player_b"); err != nil {
+		t.Fatalf("failed to Declaration: %v", err)
+	}
+	return b
+}
+
+func attack(t *testing.T, r Rule, b *Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) {
+	t.Helper()
+
+	if err := r.Attack(b, player, pos, size); err != nil {
+		t.Fatalf("failed to Attack: %v", err)
+	}
+}
+
+func relocate(t *testing.T, r Rule, b *Battle, player tictactoe_battle.Player, from, to tictactoe_battle.Position, size tictactoe_battle.Piece) {
+	t.Helper()
+
+	if err := r.Pick(b, player, from, size); err != nil {
+		t.Fatalf("failed to Pick: %v", err)
+	}
+	attack(t, r, b, player, to, size)
+}
+
+func TestRule_Draw(t *testing.T) {
+	t.Run("threefold repetition", func(t *testing.T) {
+		r := NewRule()
+		b := startBattle(t, r)
+
+		attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L)
+		attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X2Y2, tictactoe_battle.Piece_PIECE_L)
+
+		for i := 0; i < 2; i++ {
+			relocate(t, r, b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Position_POSITION_X1Y0, tictactoe_battle.Piece_PIECE_L)
+			relocate(t, r, b, playerB, tictactoe_battle.Position_POSITION_X2Y2, tictactoe_battle.Position_POSITION_X1Y2, tictactoe_battle.Piece_PIECE_L)
+			relocate(t, r, b, playerA, tictactoe_battle.Position_POSITION_X1Y0, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L)
+			if b.State == management_state.Draw {
+				t.Fatalf("unexpected draw at cycle %d", i)
+			}
+			relocate(t, r, b, playerB, tictactoe_battle.Position_POSITION_X1Y2, tictactoe_battle.Position_POSITION_X2Y2, tictactoe_battle.Piece_PIECE_L)
+		}
+
+		if b.State != management_state.Draw {
+			t.Fatalf("wanted draw but got %d", b.State)
+		}
+		if err := r.Attack(b, playerA, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_S); err == nil {
+			t.Fatalf("wanted error after draw")
+		}
+	})
+
+	t.Run("max moves", func(t *testing.T) {
+		rs := DefaultRuleSet()
+		rs.MaxMoves = 2
+		r := NewRule()
+		b := startBattleWith(t, r, rs)
+
+		attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L)
+		if b.State != management_state.PlayerBTurn {
+			t.Fatalf("wanted player b turn but got %d", b.State)
+		}
+		attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X2Y2, tictactoe_battle.Piece_PIECE_L)
+		if b.State != management_state.Draw {
+			t.Fatalf("wanted draw but got %d", b.State)
+		}
+	})
+
+	t.Run("win takes precedence", func(t *testing.T) {
+		rs := DefaultRuleSet()
+		rs.MaxMoves = 5
+		r := NewRule()
+		b := startBattleWith(t, r, rs)
+
+		attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L)
+		attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X0Y1, tictactoe_battle.Piece_PIECE_L)
+		attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X1Y0, tictactoe_battle.Piece_PIECE_L)
+		attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L)
+		attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X2Y0, tictactoe_battle.Piece_PIECE_M)
+
+		if b.State != management_state.PlayerAWin {
+			t.Fatalf("wanted player a win but got %d", b.State)
+		}
+	})
+}
+
+func TestRule_Pick_UncoverOpponentLines(t *testing.T) {
+	t.Run("opponent line wins over mover line", func(t *testing.T) {
+		r := NewRule()
+		b := startBattle(t, r)
+
+		// A A A
+		// B A B  (中央はBのMをAのLで覆っている)
+		// - - -
+		for _, pos := range []tictactoe_battle.Position{0, 1, 2} {
+			b.Field[pos].L = playerA
+		}
+		b.Field[3].M = playerB
+		b.Field[5].M = playerB
+		b.Field[4].M = playerB
+		b.Field[4].L = playerA
+
+		if err := r.Pick(b, playerA, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L); err != nil {
+			t.Fatalf("failed to Pick: %v", err)
+		}
+		if b.State != management_state.PlayerBWin {
+			t.Fatalf("wanted player b win but got %d", b.State)
+		}
+		if b.WinLine != tictactoe_battle.WinLine_WIN_LINE_2 {
+			t.Fatalf("wanted %s but got %s", tictactoe_battle.WinLine_WIN_LINE_2, b.WinLine)
+		}
+	})
+
+	t.Run("all opponent lines are reported", func(t *testing.T) {
+		r := NewRule()
+		b := startBattle(t, r)
+
+		// - B -
+		// B A B  (中央はBのMをAのLで覆っている)
+		// - B -
+		for _, pos := range []tictactoe_battle.Position{1, 3, 5, 7} {
+			b.Field[pos].M = playerB
+		}
+		b.Field[4].M = playerB
+		b.Field[4].L = playerA
+
+		if err := r.Pick(b, playerA, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L); err != nil {
+			t.Fatalf("failed to Pick: %v", err)
+		}
+		if b.State != management_state.PlayerBWin {
+			t.Fatalf("wanted player b win but got %d", b.State)
+		}
+		want := []tictactoe_battle.WinLine{tictactoe_battle.WinLine_WIN_LINE_2, tictactoe_battle.WinLine_WIN_LINE_5}
+		if diff := cmp.Diff(want, b.WinLines); diff != "" {
+			t.Fatalf(diff)
+		}
+		if b.WinLine != want[0] {
+			t.Fatalf("wanted %s but got %s", want[0], b.WinLine)
+		}
+	})
+}
+
+func TestRule_LegalMoves(t *testing.T) {
+	r := NewRule()
+
+	// 全ての組み合わせを実際に試した結果とLegalMovesが一致することを確認する
+	bruteForce := func(b *Battle, player tictactoe_battle.Player) []Action {
+		var actions []Action
+		froms := []tictactoe_battle.Position{tictactoe_battle.Position_POSITION_UNDEFINED}
+		if b.PickedPosition != tictactoe_battle.Position_POSITION_UNDEFINED {
+			froms = []tictactoe_battle.Position{b.PickedPosition}
+		} else {
+			for i := range b.Field {
+				froms = append(froms, tictactoe_battle.Position(i))
+			}
+		}
+		for _, from := range froms {
+			for _, size := range pieces {
+				picked := b.Clone()
+				if from != tictactoe_battle.Position_POSITION_UNDEFINED && b.PickedPosition == tictactoe_battle.Position_POSITION_UNDEFINED {
+					if topPiece(b.Field[from]) != size || r.Pick(picked, player, from, size) != nil {
+						continue
+					}
+					if picked.State.IsFinished() {
+						actions = append(actions, Action{From: from, To: tictactoe_battle.Position_POSITION_UNDEFINED, Piece: size})
+						continue
+					}
+				}
+				for to := range b.Field {
+					if r.Attack(picked.Clone(), player, tictactoe_battle.Position(to), size) == nil {
+						actions = append(actions, Action{From: from, To: tictactoe_battle.Position(to), Piece: size})
+					}
+				}
+			}
+		}
+		return actions
+	}
+	sortActions := cmpopts.SortSlices(func(x, y Action) bool {
+		if x.From != y.From {
+			return x.From < y.From
+		}
+		if x.Piece != y.Piece {
+			return x.Piece < y.Piece
+		}
+		return x.To < y.To
+	})
+
+	tests := []struct {
+		name   string
+		setup  func(t *testing.T) *Battle
+		player tictactoe_battle.Player
+		want   int
+	}{
+		{
+			name:   "opening",
+			setup:  func(t *testing.T) *Battle { return startBattle(t, r) },
+			player: playerA,
+			want:   27,
+		},
+		{
+			name:   "not your turn",
+			setup:  func(t *testing.T) *Battle { return startBattle(t, r) },
+			player: playerB,
+			want:   0,
+		},
+		{
+			name: "picked",
+			setup: func(t *testing.T) *Battle {
+				b := startBattle(t, r)
+				attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_M)
+				attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L)
+				if err := r.Pick(b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_M); err != nil {
+					t.Fatalf("failed to Pick: %v", err)
+				}
+				return b
+			},
+			player: playerA,
+			want:   7,
+		},
+		{
+			name: "pick reveals opponent line",
+			setup: func(t *testing.T) *Battle {
+				b := startBattle(t, r)
+				for _, pos := range []tictactoe_battle.Position{3, 4, 5} {
+					b.Field[pos].M = playerB
+				}
+				b.Field[4].L = playerA
+				return b
+			},
+			player: playerA,
+			want:   -1,
+		},
+		{
+			name: "mid game",
+			setup: func(t *testing.T) *Battle {
+				b := startBattle(t, r)
+				attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_S)
+				attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_M)
+				attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L)
+				attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X2Y2, tictactoe_battle.Piece_PIECE_S)
+				return b
+			},
+			player: playerA,
+			want:   -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := tt.setup(t)
+			hash := PositionHash(b)
+
+			got := r.LegalMoves(b, tt.player)
+			if tt.want >= 0 && len(got) != tt.want {
+				t.Fatalf("wanted %d moves but got %d: %v", tt.want, len(got), got)
+			}
+			if diff := cmp.Diff(bruteForce(b, tt.player), got, sortActions, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf(diff)
+			}
+			if has := r.(*rule).hasLegalMove(b, tt.player); has != (len(got) > 0) {
+				t.Fatalf("wanted hasLegalMove %t but got %t", len(got) > 0, has)
+			}
+			if PositionHash(b) != hash {
+				t.Fatalf("LegalMoves must not modify battle")
+			}
+		})
+	}
+}
+
+func TestRule_RuleSet(t *testing.T) {
+	t.Run("tictactoe disables relocation", func(t *testing.T) {
+		r := NewRule()
+		b := r.OpenBattle(ruleSetPresets["tictactoe"])
+		for _, id := range []string{"player_a", "player_b"} {
+			if err := r.Declaration(b, id); err != nil {
+				t.Fatalf("failed to Declaration: %v", err)
+			}
+		}
+
+		attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L)
+		attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L)
+		err := r.Pick(b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L)
+		if v, ok := AsViolationError(err); !ok || v.Violation != ViolationRelocationDisabled {
+			t.Fatalf("wanted %s but got %v", ViolationRelocationDisabled, err)
+		}
+		err = r.Attack(b, playerA, tictactoe_battle.Position_POSITION_X1Y0, tictactoe_battle.Piece_PIECE_M)
+		if v, ok := AsViolationError(err); !ok || v.Violation != ViolationMissingPiece {
+			t.Fatalf("wanted %s but got %v", ViolationMissingPiece, err)
+		}
+	})
+
+	t.Run("tictactoe draws on a full board", func(t *testing.T) {
+		// A B A
+		// A B B
+		// B A A
+		r := NewRule()
+		b := r.OpenBattle(ruleSetPresets["tictactoe"])
+		for _, id := range []string{"player_a", "player_b"} {
+			if err := r.Declaration(b, id); err != nil {
+				t.Fatalf("failed to Declaration: %v", err)
+			}
+		}
+
+		for i, pos := range []int{0, 4, 8, 1, 7, 6, 2, 5, 3} {
+			player := playerA
+			if i%2 == 1 {
+				player = playerB
+			}
+			attack(t, r, b, player, tictactoe_battle.Position(pos), tictactoe_battle.Piece_PIECE_L)
+		}
+		if b.State != management_state.Draw {
+			t.Fatalf("wanted draw but got %d", b.State)
+		}
+	})
+
+	t.Run("own gobble", func(t *testing.T) {
+		tests := []struct {
+			name string
+			rs   RuleSet
+			want Violation
+		}{
+			{name: "default", rs: DefaultRuleSet(), want: ViolationStackOnOwnPiece},
+			{name: "own_gobble", rs: ruleSetPresets["own_gobble"]},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				r := NewRule()
+				b := r.OpenBattle(tt.rs)
+				for _, id := range []string{"player_a", "player_b"} {
+					if err := r.Declaration(b, id); err != nil {
+						t.Fatalf("failed to Declaration: %v", err)
+					}
+				}
+
+				attack(t, r, b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_S)
+				attack(t, r, b, playerB, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_S)
+				err := r.Attack(b, playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_M)
+				v, _ := AsViolationError(err)
+				if v.Violation != tt.want {
+					t.Fatalf("wanted %q but got %v", tt.want, err)
+				}
+			})
+		}
+	})
+}
+
+func TestRule_ResignAndDraw(t *testing.T) {
+	tests := []struct {
+		name          string
+		moves         []*Move
+		wantState     management_state.State
+		wantViolation Violation
+	}{
+		{
+			name:      "resign on opponent's turn",
+			moves:     []*Move{NewResign(playerB)},
+			wantState: management_state.PlayerBResigned,
+		},
+		{
+			name:      "accept draw",
+			moves:     []*Move{NewOfferDraw(playerA), NewAcceptDraw(playerB)},
+			wantState: management_state.DrawAgreed,
+		},
+		{
+			name:      "decline draw",
+			moves:     []*Move{NewOfferDraw(playerA), NewDeclineDraw(playerB)},
+			wantState: management_state.PlayerATurn,
+		},
+		{
+			name:          "offer draw on opponent's turn",
+			moves:         []*Move{NewOfferDraw(playerB)},
+			wantState:     management_state.PlayerATurn,
+			wantViolation: ViolationNotYourTurn,
+		},
+		{
+			name:          "accept own offer",
+			moves:         []*Move{NewOfferDraw(playerA), NewAcceptDraw(playerA)},
+			wantState:     management_state.PlayerADrawOffered,
+			wantViolation: ViolationNoDrawOffer,
+		},
+		{
+			name:          "attack while offering draw",
+			moves:         []*Move{NewOfferDraw(playerA), NewAttack(playerA, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L)},
+			wantState:     management_state.PlayerADrawOffered,
+			wantViolation: ViolationNotYourTurn,
+		},
+		{
+			name:          "resign after finished",
+			moves:         []*Move{NewResign(playerA), NewResign(playerB)},
+			wantState:     management_state.PlayerAResigned,
+			wantViolation: ViolationNotInProgress,
+		},
+		{
+			name:          "audience resigns",
+			moves:         []*Move{NewResign(tictactoe_battle.Player_PLAYER_AUDIENCE)},
+			wantState:     management_state.PlayerATurn,
+			wantViolation: ViolationNotInProgress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRule()
+			b := startBattle(t, r)
+
+			var err error
+			for _, m := range tt.moves {
+				if err = Apply(r, b, m); err != nil {
+					break
+				}
+			}
+			if v, _ := AsViolationError(err); v.Violation != tt.wantViolation {
+				t.Fatalf("wanted violation %q but got %v", tt.wantViolation, err)
+			}
+			if b.State != tt.wantState {
+				t.Fatalf("wanted state %d but got %d", tt.wantState, b.State)
+			}
+		})
+	}
+}
+
+func TestRule_RequestReset(t *testing.T) {
+	r := NewRule()
+	b := startBattle(t, r)
+
+	if err := r.RequestReset(b, playerA); err != nil {
+		t.Fatalf("failed to RequestReset: %v", err)
+	}
+	if b.ResetAgreed(playerA) || !b.ResetAgreed(playerB) {
+		t.Fatalf("wanted reset to be agreed only by player b's request")
+	}
+
+	r.Reset(b)
+	if b.ResetRequestedBy != tictactoe_battle.Player_PLAYER_UNKNOWN {
+		t.Fatalf("wanted reset request to be cleared but got %s", b.ResetRequestedBy)
+	}
+}
+
+func TestRule_RequestResetClearedByMove(t *testing.T) {
+	r := NewRule()
+	b := startBattle(t, r)
+
+	if err := r.RequestReset(b, playerA); err != nil {
+		t.Fatalf("failed to RequestReset: %v", err)
+	}
+	if err := r.Attack(b, playerA, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_S); err != nil {
+		t.Fatalf("failed to Attack: %v", err)
+	}
+	if b.ResetRequestedBy != tictactoe_battle.Player_PLAYER_UNKNOWN || b.ResetAgreed(playerB) {
+		t.Fatalf("wanted reset request to be cleared by a move but got %s", b.ResetRequestedBy)
+	}
+}
+
+func TestRule_Rematch(t *testing.T) {
+	r := NewRule()
+	b := startBattle(t, r)
+
+	if _, err := r.AcceptRematch(b, playerB); err == nil {
+		t.Fatalf("wanted error before the game is finished")
+	}
+	for _, m := range []*Move{NewResign(playerB), NewRematchRequest(playerA)} {
+		if err := Apply(r, b, m); err != nil {
+			t.Fatalf("failed to Apply: %v", err)
+		}
+	}
+	if _, err := r.AcceptRematch(b, playerA); err == nil {
+		t.Fatalf("wanted error when accepting own request")
+	}
+
+	rm, err := r.AcceptRematch(b, playerB)
+	if err != nil {
+		t.Fatalf("failed to AcceptRematch: %v", err)
+	}
+	// 直前のゲームが無くても同じ局面から始まる
+	for _, base := range []*Battle{b, r.OpenBattle(DefaultRuleSet())} {
+		if err := Apply(r, base, NewRematch(DefaultRuleSet(), TimeControl{}, false, rm)); err != nil {
+			t.Fatalf("failed to Apply: %v", err)
+		}
+		want := Rematch{PlayerAID: "player_b", PlayerBID: "player_a", Series: Series{PlayerBWins: 1}}
+		got := Rematch{PlayerAID: base.PlayerAID, PlayerBID: base.PlayerBID, Series: base.Series}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("(-want +got)\n%s", diff)
+		}
+		if base.State != management_state.PlayerATurn || base.RematchRequestedBy != tictactoe_battle.Player_PLAYER_UNKNOWN {
+			t.Fatalf("unexpected rematch state: %d, %s", base.State, base.RematchRequestedBy)
+		}
+	}
+}
+
+func TestRule_InvalidMoveKeepsBattle(t *testing.T) {
+	const (
+		x0y0 = tictactoe_battle.Position_POSITION_X0Y0
+		x1y1 = tictactoe_battle.Position_POSITION_X1Y1
+		x2y0 = tictactoe_battle.Position_POSITION_X2Y0
+		x0y2 = tictactoe_battle.Position_POSITION_X0Y2
+		x1y2 = tictactoe_battle.Position_POSITION_X1Y2
+		x2y1 = tictactoe_battle.Position_POSITION_X2Y1
+		x2y2 = tictactoe_battle.Position_POSITION_X2Y2
+
+		undefined  = tictactoe_battle.Position_POSITION_UNDEFINED
+		outOfField = tictactoe_battle.Position(9)
+
+		s = tictactoe_battle.Piece_PIECE_S
+		m = tictactoe_battle.Piece_PIECE_M
+		l = tictactoe_battle.Piece_PIECE_L
+	)
+
+	tests := []struct {
+		name  string
+		setup []*Move
+		move  *Move
+		want  Violation
+	}{
+		{name: "pick on opponent's turn", setup: []*Move{NewAttack(playerA, x0y0, l)}, move: NewPick(playerA, x0y0, l), want: ViolationNotYourTurn},
+		{name: "pick undefined position", move: NewPick(playerA, undefined, l), want: ViolationInvalidPosition},
+		{name: "pick out of field", move: NewPick(playerA, outOfField, l), want: ViolationInvalidPosition},
+		{name: "pick empty cell", move: NewPick(playerA, x0y0, l), want: ViolationNotOwnPiece},
+		{name: "pick opponent's piece", setup: []*Move{NewAttack(playerA, x0y0, s), NewAttack(playerB, x2y2, m)}, move: NewPick(playerA, x2y2, m), want: ViolationNotOwnPiece},
+		{name: "pick covered piece", setup: []*Move{NewAttack(playerA, x0y0, s), NewAttack(playerB, x0y0, m)}, move: NewPick(playerA, x0y0, s), want: ViolationLargerPiecePlaced},
+		{name: "pick unknown piece", setup: []*Move{NewAttack(playerA, x0y0, s), NewAttack(playerB, x2y2, m)}, move: NewPick(playerA, x0y0, tictactoe_battle.Piece_PIECE_UNKNOWN), want: ViolationInvalidPiece},
+		{name: "pick twice", setup: []*Move{NewAttack(playerA, x0y0, s), NewAttack(playerB, x2y2, m), NewAttack(playerA, x1y1, l), NewAttack(playerB, x2y0, s), NewPick(playerA, x0y0, s)}, move: NewPick(playerA, x1y1, l), want: ViolationAlreadyPicked},
+		{name: "opponent picks while picked", setup: []*Move{NewAttack(playerA, x0y0, s), NewAttack(playerB, x2y2, m), NewPick(playerA, x0y0, s)}, move: NewPick(playerB, x2y2, m), want: ViolationNotYourTurn},
+		{name: "attack on opponent's turn", move: NewAttack(playerB, x0y0, l), want: ViolationNotYourTurn},
+		{name: "attack undefined position", move: NewAttack(playerA, undefined, l), want: ViolationInvalidPosition},
+		{name: "attack out of field", move: NewAttack(playerA, outOfField, l), want: ViolationInvalidPosition},
+		{name: "attack unknown piece", move: NewAttack(playerA, x0y0, tictactoe_battle.Piece_PIECE_UNKNOWN), want: ViolationInvalidPiece},
+		{name: "attack picked position", setup: []*Move{NewAttack(playerA, x0y0, s), NewAttack(playerB, x2y2, m), NewPick(playerA, x0y0, s)}, move: NewAttack(playerA, x0y0, s), want: ViolationSamePosition},
+		{name: "attack with another piece while picked", setup: []*Move{NewAttack(playerA, x0y0, s), NewAttack(playerB, x2y2, m), NewPick(playerA, x0y0, s)}, move: NewAttack(playerA, x1y1, l), want: ViolationNotPickedPiece},
+		{name: "attack without holding", setup: []*Move{NewAttack(playerA, x0y0, l), NewAttack(playerB, x2y0, s), NewAttack(playerA, x1y2, l), NewAttack(playerB, x0y2, s)}, move: NewAttack(playerA, x2y1, l), want: ViolationMissingPiece},
+		{name: "attack on larger piece", setup: []*Move{NewAttack(playerA, x0y0, l), NewAttack(playerB, x1y1, m)}, move: NewAttack(playerA, x1y1, s), want: ViolationLargerPiecePlaced},
+		{name: "attack on own piece", setup: []*Move{NewAttack(playerA, x0y0, s), NewAttack(playerB, x2y2, s)}, move: NewAttack(playerA, x0y0, m), want: ViolationStackOnOwnPiece},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRule()
+			b := startBattle(t, r)
+			for _, m := range tt.setup {
+				if err := Apply(r, b, m); err != nil {
+					t.Fatalf("failed to Apply: %v", err)
+				}
+			}
+			before := b.Clone()
+
+			err := Apply(r, b, tt.move)
+			if v, _ := AsViolationError(err); v.Violation != tt.want {
+				t.Fatalf("wanted violation %q but got %v", tt.want, err)
+			}
+			if diff := cmp.Diff(before, b, protocmp.Transform()); diff != "" {
+				t.Fatalf("battle was changed by a rejected move (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
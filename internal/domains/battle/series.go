@@ -0,0 +1,46 @@
+package battle
+
+import (
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+)
+
+type (
+	// Series is
+	//   同じ2人のプレイヤーが再戦を続けた通算成績. 席ごとに記録し、再戦で席を入れ替える際に成績も入れ替える.
+	Series struct {
+		PlayerAWins int `json:"player_a_wins"`
+		PlayerBWins int `json:"player_b_wins"`
+		Draws       int `json:"draws"`
+	}
+
+	// Rematch is
+	//   再戦で始まるゲームの席と通算成績. MoveRematchに記録し、直前のゲームが無くても再構築できるようにする.
+	Rematch struct {
+		PlayerAID string `json:"player_aid"`
+		PlayerBID string `json:"player_bid"`
+		Series    Series `json:"series"`
+	}
+)
+
+// record 終了したbのゲームの結果を加える.
+func (s *Series) record(b *Battle) {
+	switch b.Winner() {
+	case tictactoe_battle.Player_PLAYER_A:
+		s.PlayerAWins++
+	case tictactoe_battle.Player_PLAYER_B:
+		s.PlayerBWins++
+	default:
+		if b.State.IsDraw() {
+			s.Draws++
+		}
+	}
+}
+
+// swapped 席を入れ替えた後の成績を返す.
+func (s Series) swapped() Series {
+	return Series{
+		PlayerAWins: s.PlayerBWins,
+		PlayerBWins: s.PlayerAWins,
+		Draws:       s.Draws,
+	}
+}
@@ -0,0 +1,80 @@
+package battle
+
+import (
+	"fmt"
+
+	"golang.org/x/xerrors"
+)
+
+type (
+	// Violation is
+	//   ルール違反の種別. clientが判定に使用するため値は変更しないこと.
+	Violation string
+
+	ViolationError struct {
+		Violation Violation
+		error
+	}
+
+	// violationMessage LegalMovesなどで大量に判定されるため、messageはError()の呼び出し時に組み立てる.
+	violationMessage struct {
+		format string
+		args   []interface{}
+	}
+)
+
+const (
+	ViolationNotYourTurn       Violation = "NOT_YOUR_TURN"
+	ViolationInvalidPosition   Violation = "INVALID_POSITION"
+	ViolationInvalidPiece      Violation = "INVALID_PIECE"
+	ViolationSamePosition      Violation = "SAME_POSITION"
+	ViolationNotPickedPiece    Violation = "NOT_PICKED_PIECE"
+	ViolationMissingPiece      Violation = "MISSING_PIECE"
+	ViolationLargerPiecePlaced Violation = "LARGER_PIECE_PLACED"
+	ViolationStackOnOwnPiece   Violation = "STACK_ON_OWN_PIECE"
+	ViolationNotOwnPiece       Violation = "NOT_OWN_PIECE"
+	// ViolationAlreadyPicked 既に駒を持ち上げているため、置くまで他の駒を持ち上げられない.
+	ViolationAlreadyPicked Violation = "ALREADY_PICKED"
+	// ViolationRelocationDisabled RuleSetで盤上の駒の移動が禁止されている.
+	ViolationRelocationDisabled Violation = "RELOCATION_DISABLED"
+	// ViolationUnsupportedMode battleのModeでは使用できない操作.
+	ViolationUnsupportedMode Violation = "UNSUPPORTED_MODE"
+	// ViolationGobbleNotAllowed ModeGobbletで外部の山の駒は3つ並んだ相手の駒にしか被せられない.
+	ViolationGobbleNotAllowed Violation = "GOBBLE_NOT_ALLOWED"
+	// ViolationTimeExpired 手番のプレイヤーの持ち時間が切れている.
+	ViolationTimeExpired Violation = "TIME_EXPIRED"
+	// ViolationTimeRemaining 持ち時間が残っているため時間切れにできない.
+	ViolationTimeRemaining Violation = "TIME_REMAINING"
+	// ViolationNotInProgress 対局中でないため投了や引き分けの提案はできない.
+	ViolationNotInProgress Violation = "NOT_IN_PROGRESS"
+	// ViolationNoDrawOffer 相手から引き分けを提案されていない.
+	ViolationNoDrawOffer Violation = "NO_DRAW_OFFER"
+	// ViolationNotFinished ゲームが終了していないため再戦を要求できない.
+	ViolationNotFinished Violation = "NOT_FINISHED"
+	// ViolationNoRematchRequest 相手から再戦を要求されていない.
+	ViolationNoRematchRequest Violation = "NO_REMATCH_REQUEST"
+)
+
+func newViolationError(v Violation, format string, args ...interface{}) ViolationError {
+	return ViolationError{
+		Violation: v,
+		error:     violationMessage{format: format, args: args},
+	}
+}
+
+func (m violationMessage) Error() string {
+	return fmt.Sprintf(m.format, m.args...)
+}
+
+func IsViolationError(err error) bool {
+	return xerrors.As(err, &ViolationError{})
+}
+
+// AsViolationError errに含まれるルール違反を取り出す.
+func AsViolationError(err error) (ViolationError, bool) {
+	var v ViolationError
+	if !xerrors.As(err, &v) {
+		return ViolationError{}, false
+	}
+	return v, true
+}
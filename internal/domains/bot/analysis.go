@@ -0,0 +1,84 @@
+package bot
+
+import (
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/tablebase"
+	"golang.org/x/xerrors"
+)
+
+type (
+	Outcome int
+
+	// Analysis is
+	//   局面の解析結果. 手番のプレイヤーの最善手と、最善を尽くした場合の結末.
+	Analysis struct {
+		Action  battle.Action
+		Outcome Outcome
+		// Moves 勝敗が決まるまでの勝者の手数. OutcomeがWinまたはLossの場合のみ設定される.
+		Moves int
+	}
+)
+
+const (
+	// OutcomeUndecided 制限時間内に勝敗を読み切れなかった.
+	OutcomeUndecided Outcome = iota + 1
+	OutcomeWin
+	OutcomeDraw
+	OutcomeLoss
+)
+
+// Analyze bの手番のプレイヤーの最善手と評価を求める. bは変更しない.
+//   tbに記録されている局面は読み切った結果を返し、それ以外は制限時間内で探索する. tbはnilでもよい.
+func Analyze(rule battle.Rule, tb *tablebase.Tablebase, b *battle.Battle) (*Analysis, error) {
+	player := b.Turn()
+	if player == tictactoe_battle.Player_PLAYER_UNKNOWN {
+		return nil, xerrors.Errorf("battle is not in progress: %d", b.State)
+	}
+
+	if tb != nil {
+		if action, result, ok := tb.Best(rule, b); ok {
+			return analysisOf(action, result), nil
+		}
+	}
+
+	children := expand(rule, b)
+	if len(children) == 0 {
+		return nil, xerrors.New("no legal action")
+	}
+
+	action, score := newSearcher(rule, time.Now().Add(perfectThinkTime)).deepen(children, player)
+	ret := &Analysis{Action: action, Outcome: OutcomeUndecided}
+	for _, c := range children {
+		// 着手した時点で引き分けとなる場合は読み切ったものとする
+		if c.action == action && c.battle.State.IsFinished() && c.battle.Winner() == tictactoe_battle.Player_PLAYER_UNKNOWN {
+			ret.Outcome = OutcomeDraw
+		}
+	}
+
+	// 評価値はwinScoreから勝敗が決まった手番までのply(着手後の局面を0とする)を引いたもの
+	switch {
+	case score >= winScore-perfectMaxDepth:
+		ret.Outcome = OutcomeWin
+		ret.Moves = (winScore-score)/2 + 1
+	case score <= -(winScore - perfectMaxDepth):
+		ret.Outcome = OutcomeLoss
+		ret.Moves = (winScore + score + 1) / 2
+	}
+
+	return ret, nil
+}
+
+// analysisOf tablebaseの結末をAnalysisに変換する.
+func analysisOf(action battle.Action, result tablebase.Result) *Analysis {
+	switch {
+	case result.IsWin():
+		return &Analysis{Action: action, Outcome: OutcomeWin, Moves: (result.Plies() + 1) / 2}
+	case result.IsLoss():
+		return &Analysis{Action: action, Outcome: OutcomeLoss, Moves: result.Plies() / 2}
+	default:
+		return &Analysis{Action: action, Outcome: OutcomeDraw}
+	}
+}
@@ -0,0 +1,42 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+)
+
+func TestAnalyze(t *testing.T) {
+	r := battle.NewRule()
+
+	// A A -
+	// B B -
+	// - - -
+	b := startBattle(t, r)
+	for _, a := range []battle.Action{
+		{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X0Y0, Piece: tictactoe_battle.Piece_PIECE_L},
+		{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X0Y1, Piece: tictactoe_battle.Piece_PIECE_L},
+		{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X1Y0, Piece: tictactoe_battle.Piece_PIECE_L},
+		{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X1Y1, Piece: tictactoe_battle.Piece_PIECE_L},
+	} {
+		playAction(t, r, b, a)
+	}
+
+	got, err := Analyze(r, nil, b)
+	if err != nil {
+		t.Fatalf("failed to Analyze: %v", err)
+	}
+	if got.Outcome != OutcomeWin || got.Moves != 1 {
+		t.Fatalf("wanted win in 1 but got %+v", got)
+	}
+
+	playAction(t, r, b, got.Action)
+	if b.Winner() != tictactoe_battle.Player_PLAYER_A {
+		t.Fatalf("wanted player a win by %+v but got %d", got.Action, b.State)
+	}
+
+	if _, err := Analyze(r, nil, b); err == nil {
+		t.Fatalf("wanted error for finished battle")
+	}
+}
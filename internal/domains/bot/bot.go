@@ -0,0 +1,122 @@
+package bot
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/tablebase"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// idPrefix botのloginID. ログインできないIDとするためLoginInteractorで使用を禁止している.
+	idPrefix = "bot:"
+)
+
+type (
+	Level int
+
+	// Bot is
+	//   battle.Ruleに従って手番の着手を選ぶプレイヤー.
+	Bot struct {
+		level     Level
+		rule      battle.Rule
+		tablebase *tablebase.Tablebase
+		rand      *rand.Rand
+	}
+)
+
+const (
+	// LevelRandom 合法手から無作為に選ぶ.
+	LevelRandom Level = iota + 1
+	LevelEasy
+	LevelNormal
+	LevelHard
+	// LevelPerfect 制限時間内で読み切れる限り最善を尽くす.
+	LevelPerfect
+)
+
+var levelNames = map[Level]string{
+	LevelRandom:  "random",
+	LevelEasy:    "easy",
+	LevelNormal:  "normal",
+	LevelHard:    "hard",
+	LevelPerfect: "perfect",
+}
+
+// New levelのbotを返す. tbが指定された場合、LevelPerfectは記録されている局面で探索せずにtbの最善手を選ぶ.
+func New(level Level, rule battle.Rule, tb *tablebase.Tablebase) *Bot {
+	return &Bot{
+		level:     level,
+		rule:      rule,
+		tablebase: tb,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// ParseLevel levelの名前からLevelを返す.
+func ParseLevel(name string) (Level, error) {
+	for l, n := range levelNames {
+		if strings.EqualFold(n, name) {
+			return l, nil
+		}
+	}
+	return 0, exceptions.NewInvalidArgumentError(fmt.Sprintf("unknown bot level: %s", name))
+}
+
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+// ID levelのbotのloginID.
+func (l Level) ID() string {
+	return idPrefix + l.String()
+}
+
+// IsID loginIDがbotのものか.
+func IsID(loginID string) bool {
+	return strings.HasPrefix(loginID, idPrefix)
+}
+
+// Think bの手番のプレイヤーとして着手を選ぶ. bは変更しない.
+func (bt *Bot) Think(b *battle.Battle) (battle.Action, error) {
+	player := b.Turn()
+	if player == tictactoe_battle.Player_PLAYER_UNKNOWN {
+		return battle.Action{}, xerrors.Errorf("battle is not in progress: %d", b.State)
+	}
+
+	children := expand(bt.rule, b)
+	if len(children) == 0 {
+		return battle.Action{}, xerrors.New("no legal action")
+	}
+	// 同じ評価の手が複数ある場合に毎回同じ手とならないよう並びを崩す
+	bt.rand.Shuffle(len(children), func(i, j int) {
+		children[i], children[j] = children[j], children[i]
+	})
+
+	switch bt.level {
+	case LevelRandom:
+		return children[0].action, nil
+	case LevelEasy:
+		return newSearcher(bt.rule, time.Time{}).best(children, player, 1), nil
+	case LevelNormal:
+		return newSearcher(bt.rule, time.Time{}).best(children, player, 2), nil
+	case LevelHard:
+		return newSearcher(bt.rule, time.Time{}).best(children, player, 4), nil
+	case LevelPerfect:
+		if bt.tablebase != nil {
+			if action, _, ok := bt.tablebase.Best(bt.rule, b); ok {
+				return action, nil
+			}
+		}
+		action, _ := newSearcher(bt.rule, time.Now().Add(perfectThinkTime)).deepen(children, player)
+		return action, nil
+	default:
+		return battle.Action{}, xerrors.Errorf("unexpected bot level: %d", bt.level)
+	}
+}
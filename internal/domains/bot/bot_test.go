@@ -0,0 +1,103 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+)
+
+func startBattle(t *testing.T, r battle.Rule) *battle.Battle {
+	t.Helper()
+
+	b := r.OpenBattle(battle.DefaultRuleSet())
+	for _, id := range []string{"player_a", "player_b"} {
+		if err := r.Declaration(b, id); err != nil {
+			t.Fatalf("failed to Declaration: %v", err)
+		}
+	}
+	return b
+}
+
+func playAction(t *testing.T, r battle.Rule, b *battle.Battle, a battle.Action) {
+	t.Helper()
+
+	if err := battle.Play(r, b, b.Turn(), a); err != nil {
+		t.Fatalf("failed to play %+v: %v", a, err)
+	}
+}
+
+func TestBot_Think(t *testing.T) {
+	r := battle.NewRule()
+
+	t.Run("every level plays legal moves to the end", func(t *testing.T) {
+		for _, level := range []Level{LevelRandom, LevelEasy, LevelNormal} {
+			b := startBattle(t, r)
+			bt := New(level, r, nil)
+			for i := 0; i < 100 && !b.State.IsFinished(); i++ {
+				a, err := bt.Think(b)
+				if err != nil {
+					t.Fatalf("failed to Think: %v", err)
+				}
+				playAction(t, r, b, a)
+			}
+		}
+	})
+
+	// A A -
+	// B B -
+	// - - -
+	newThreat := func(t *testing.T) *battle.Battle {
+		b := startBattle(t, r)
+		for _, a := range []battle.Action{
+			{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X0Y0, Piece: tictactoe_battle.Piece_PIECE_L},
+			{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X0Y1, Piece: tictactoe_battle.Piece_PIECE_L},
+			{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X1Y0, Piece: tictactoe_battle.Piece_PIECE_L},
+			{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X1Y1, Piece: tictactoe_battle.Piece_PIECE_L},
+		} {
+			playAction(t, r, b, a)
+		}
+		return b
+	}
+
+	for _, level := range []Level{LevelEasy, LevelNormal, LevelHard, LevelPerfect} {
+		t.Run(level.String()+" takes the winning move", func(t *testing.T) {
+			b := newThreat(t)
+			a, err := New(level, r, nil).Think(b)
+			if err != nil {
+				t.Fatalf("failed to Think: %v", err)
+			}
+			playAction(t, r, b, a)
+			if b.Winner() != tictactoe_battle.Player_PLAYER_A {
+				t.Fatalf("wanted player a win by %+v but got %d", a, b.State)
+			}
+		})
+	}
+
+	t.Run("hard thinks in time", func(t *testing.T) {
+		b := startBattle(t, r)
+		started := time.Now()
+		if _, err := New(LevelHard, r, nil).Think(b); err != nil {
+			t.Fatalf("failed to Think: %v", err)
+		}
+		if elapsed := time.Since(started); elapsed > perfectThinkTime {
+			t.Fatalf("took too long: %s", elapsed)
+		}
+	})
+}
+
+func TestParseLevel(t *testing.T) {
+	for l := range levelNames {
+		got, err := ParseLevel(l.String())
+		if err != nil {
+			t.Fatalf("failed to ParseLevel: %v", err)
+		}
+		if got != l {
+			t.Fatalf("wanted %d but got %d", l, got)
+		}
+	}
+	if _, err := ParseLevel("unknown"); err == nil {
+		t.Fatalf("wanted error")
+	}
+}
@@ -0,0 +1,294 @@
+package bot
+
+import (
+	"sort"
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+)
+
+const (
+	// winScore 勝ちの評価値. 早く勝てる手ほど高くなるよう手数を差し引く.
+	winScore = 100000
+	infinity = winScore * 2
+
+	perfectThinkTime = 2 * time.Second
+	perfectMaxDepth  = 32
+)
+
+type (
+	// child 着手と着手後のbattle.
+	child struct {
+		action battle.Action
+		battle *battle.Battle
+	}
+
+	bound int
+
+	entry struct {
+		depth int
+		score int
+		bound bound
+	}
+
+	searcher struct {
+		rule     battle.Rule
+		deadline time.Time
+		table    map[uint64]entry
+		aborted  bool
+		// repeated 探索中に現れた、履歴に既に出現している局面の数.
+		repeated int
+	}
+)
+
+const (
+	boundExact bound = iota
+	boundLower
+	boundUpper
+)
+
+// lineWeights ライン上に見えている自分の駒の数ごとの評価値.
+var lineWeights = [4]int{0, 1, 10, 0}
+
+func newSearcher(rule battle.Rule, deadline time.Time) *searcher {
+	return &searcher{
+		rule:     rule,
+		deadline: deadline,
+		table:    make(map[uint64]entry),
+	}
+}
+
+// expand bの手番のプレイヤーが取り得る着手を全て展開する.
+func expand(r battle.Rule, b *battle.Battle) []child {
+	player := b.Turn()
+	actions := r.LegalMoves(b, player)
+
+	children := make([]child, 0, len(actions))
+	for _, a := range actions {
+		c := b.Clone()
+		if err := battle.Play(r, c, player, a); err != nil {
+			continue
+		}
+		children = append(children, child{action: a, battle: c})
+	}
+
+	return children
+}
+
+// best depth手先まで読み、playerにとって最も評価の高い着手を返す.
+func (s *searcher) best(children []child, player tictactoe_battle.Player, depth int) battle.Action {
+	action, _ := s.search(children, player, depth)
+	return action
+}
+
+// deepen 制限時間まで読む深さを増やしながら探索する. 勝敗を読み切った時点で終了する.
+//   最後に読み切った深さでの最善手と評価値を返す.
+func (s *searcher) deepen(children []child, player tictactoe_battle.Player) (battle.Action, int) {
+	action, score := children[0].action, 0
+	for depth := 1; depth <= perfectMaxDepth; depth++ {
+		a, sc := s.search(children, player, depth)
+		if s.aborted {
+			break
+		}
+		action, score = a, sc
+		if isDecided(score) {
+			break
+		}
+
+		// 前回の最善手から探索して枝刈りを効きやすくする
+		sort.SliceStable(children, func(i, j int) bool {
+			return children[i].action == action && children[j].action != action
+		})
+	}
+	return action, score
+}
+
+// isDecided 評価値が勝敗を読み切ったものか.
+func isDecided(score int) bool {
+	return score >= winScore-perfectMaxDepth || score <= -(winScore-perfectMaxDepth)
+}
+
+func (s *searcher) search(children []child, player tictactoe_battle.Player, depth int) (battle.Action, int) {
+	bestAction, bestScore := children[0].action, -infinity
+	alpha := -infinity
+	for _, c := range children {
+		score := s.score(c, player, depth, alpha, infinity, 0)
+		if s.aborted {
+			break
+		}
+		if score > bestScore {
+			bestAction, bestScore = c.action, score
+		}
+		if score > alpha {
+			alpha = score
+		}
+	}
+	return bestAction, bestScore
+}
+
+// score playerが着手cを選んだ場合の評価値.
+func (s *searcher) score(c child, player tictactoe_battle.Player, depth, alpha, beta, ply int) int {
+	if seenBefore(c.battle) {
+		s.repeated++
+	}
+	if c.battle.State.IsFinished() {
+		switch c.battle.Winner() {
+		case tictactoe_battle.Player_PLAYER_UNKNOWN:
+			return 0
+		case player:
+			return winScore - ply
+		default:
+			return -(winScore - ply)
+		}
+	}
+	if depth <= 1 {
+		return evaluate(c.battle, player)
+	}
+	return -s.negamax(c.battle, depth-1, -beta, -alpha, ply+1)
+}
+
+// negamax bの手番のプレイヤーから見た評価値をalpha-beta法で求める.
+func (s *searcher) negamax(b *battle.Battle, depth, alpha, beta, ply int) int {
+	if !s.deadline.IsZero() && time.Now().After(s.deadline) {
+		s.aborted = true
+		return 0
+	}
+
+	// 対称な局面は評価値も等しいため同じentryを使う
+	key := battle.CanonicalHash(b)
+	if e, ok := s.table[key]; ok && e.depth >= depth {
+		score := fromTable(e.score, ply)
+		switch e.bound {
+		case boundExact:
+			return score
+		case boundLower:
+			if score > alpha {
+				alpha = score
+			}
+		case boundUpper:
+			if score < beta {
+				beta = score
+			}
+		}
+		if alpha >= beta {
+			return score
+		}
+	}
+
+	player := b.Turn()
+	children := expand(s.rule, b)
+	if len(children) == 0 {
+		return 0
+	}
+	order(children, player)
+
+	originalAlpha := alpha
+	repeated := s.repeated
+	best := -infinity
+	for _, c := range children {
+		score := s.score(c, player, depth, alpha, beta, ply)
+		if s.aborted {
+			return 0
+		}
+		if score > best {
+			best = score
+		}
+		if score > alpha {
+			alpha = score
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+
+	// 同一局面の繰り返しによる引き分けは履歴に依存するため、繰り返しが起こり得た評価値は他の履歴で使えない
+	if s.repeated != repeated {
+		return best
+	}
+
+	e := entry{depth: depth, score: toTable(best, ply), bound: boundExact}
+	switch {
+	case best <= originalAlpha:
+		e.bound = boundUpper
+	case best >= beta:
+		e.bound = boundLower
+	}
+	s.table[key] = e
+
+	return best
+}
+
+// seenBefore bの局面が履歴に既に現れているか. CanonicalHashは履歴を含まないため、
+//   このような局面を含む探索の評価値はtableに保存しない.
+func seenBefore(b *battle.Battle) bool {
+	return b.Repetitions[battle.PositionHash(b)] > 1
+}
+
+// toTable plyの局面の評価値をtableに保存する値に変換する. 同じ局面が異なるplyで現れても勝敗までの手数が正しくなるよう、
+//   勝敗を読み切った評価値はその局面から勝敗が決まるまでの手数で保存する.
+func toTable(score, ply int) int {
+	switch {
+	case score >= winScore-perfectMaxDepth:
+		return score + ply
+	case score <= -(winScore - perfectMaxDepth):
+		return score - ply
+	default:
+		return score
+	}
+}
+
+// fromTable tableに保存した値をplyの局面の評価値に変換する. toTableの逆変換.
+func fromTable(score, ply int) int {
+	switch {
+	case score >= winScore-perfectMaxDepth:
+		return score - ply
+	case score <= -(winScore - perfectMaxDepth):
+		return score + ply
+	default:
+		return score
+	}
+}
+
+// order 枝刈りが効きやすいよう、playerにとって有望な着手から並べる.
+func order(children []child, player tictactoe_battle.Player) {
+	scores := make(map[*battle.Battle]int, len(children))
+	for _, c := range children {
+		switch {
+		case c.battle.Winner() == player:
+			scores[c.battle] = winScore
+		case c.battle.State.IsFinished():
+			scores[c.battle] = -winScore
+		default:
+			scores[c.battle] = evaluate(c.battle, player)
+		}
+	}
+	sort.SliceStable(children, func(i, j int) bool {
+		return scores[children[i].battle] > scores[children[j].battle]
+	})
+}
+
+// evaluate 勝敗の決まっていない局面をplayerから見て評価する.
+//   相手の駒が無いライン上に見えている自分の駒の数で評価する.
+func evaluate(b *battle.Battle, player tictactoe_battle.Player) int {
+	score := 0
+	for _, line := range battle.Lines() {
+		var own, opponent int
+		for _, pos := range line {
+			switch b.OwnerAt(pos) {
+			case tictactoe_battle.Player_PLAYER_UNKNOWN:
+			case player:
+				own++
+			default:
+				opponent++
+			}
+		}
+		switch {
+		case opponent == 0:
+			score += lineWeights[own]
+		case own == 0:
+			score -= lineWeights[opponent]
+		}
+	}
+	return score
+}
@@ -0,0 +1,78 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+)
+
+func TestTableScore(t *testing.T) {
+	tests := []struct {
+		name       string
+		score      int
+		storedPly  int
+		probedPly  int
+		wantProbed int
+	}{
+		// ply 3の局面から2手後に勝つ局面は、ply 1で現れた場合も2手後に勝つ
+		{name: "win", score: winScore - 5, storedPly: 3, probedPly: 1, wantProbed: winScore - 3},
+		{name: "loss", score: -(winScore - 4), storedPly: 2, probedPly: 6, wantProbed: -(winScore - 8)},
+		{name: "undecided", score: 12, storedPly: 3, probedPly: 1, wantProbed: 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := toTable(tt.score, tt.storedPly)
+			if got := fromTable(stored, tt.storedPly); got != tt.score {
+				t.Fatalf("wanted %d at the same ply but got %d", tt.score, got)
+			}
+			if got := fromTable(stored, tt.probedPly); got != tt.wantProbed {
+				t.Fatalf("wanted %d but got %d", tt.wantProbed, got)
+			}
+		})
+	}
+}
+
+func TestSearcher_Repetition(t *testing.T) {
+	r := battle.NewRule()
+	newBattle := func(t *testing.T, cycles int) *battle.Battle {
+		t.Helper()
+
+		b := startBattle(t, r)
+		playAction(t, r, b, battle.Action{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X0Y0, Piece: tictactoe_battle.Piece_PIECE_L})
+		playAction(t, r, b, battle.Action{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X2Y2, Piece: tictactoe_battle.Piece_PIECE_L})
+		for i := 0; i < cycles; i++ {
+			for _, a := range []battle.Action{
+				{From: tictactoe_battle.Position_POSITION_X0Y0, To: tictactoe_battle.Position_POSITION_X1Y0, Piece: tictactoe_battle.Piece_PIECE_L},
+				{From: tictactoe_battle.Position_POSITION_X2Y2, To: tictactoe_battle.Position_POSITION_X1Y2, Piece: tictactoe_battle.Piece_PIECE_L},
+				{From: tictactoe_battle.Position_POSITION_X1Y0, To: tictactoe_battle.Position_POSITION_X0Y0, Piece: tictactoe_battle.Piece_PIECE_L},
+				{From: tictactoe_battle.Position_POSITION_X1Y2, To: tictactoe_battle.Position_POSITION_X2Y2, Piece: tictactoe_battle.Piece_PIECE_L},
+			} {
+				playAction(t, r, b, a)
+			}
+		}
+		return b
+	}
+
+	tests := []struct {
+		name       string
+		cycles     int
+		wantStored bool
+	}{
+		{name: "no history", cycles: 0, wantStored: true},
+		// 同じ局面が繰り返されているため、他の履歴で同じ局面に至った場合の評価値として使えない
+		{name: "repeated", cycles: 1, wantStored: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBattle(t, tt.cycles)
+			s := newSearcher(r, time.Time{})
+			s.negamax(b, 2, -infinity, infinity, 0)
+
+			if _, ok := s.table[battle.CanonicalHash(b)]; ok != tt.wantStored {
+				t.Fatalf("wanted stored %t but got %t", tt.wantStored, ok)
+			}
+		})
+	}
+}
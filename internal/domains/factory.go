@@ -2,24 +2,33 @@ package domains
 
 import (
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/tablebase"
 )
 
 type (
 	Factory interface {
 		BattleRule() battle.Rule
+		// Tablebase 読み込まれていない場合はnil.
+		Tablebase() *tablebase.Tablebase
 	}
 
 	factory struct {
 		battleRule battle.Rule
+		tablebase  *tablebase.Tablebase
 	}
 )
 
-func NewFactory() Factory {
+func NewFactory(tb *tablebase.Tablebase) Factory {
 	return &factory{
 		battleRule: battle.NewRule(),
+		tablebase:  tb,
 	}
 }
 
 func (f factory) BattleRule() battle.Rule {
 	return f.battleRule
 }
+
+func (f factory) Tablebase() *tablebase.Tablebase {
+	return f.tablebase
+}
@@ -0,0 +1,103 @@
+package leaderboard
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+)
+
+// 順位表の集計期間. 日別と週別はUTCで区切る.
+const (
+	BoardDaily   Board = "daily"
+	BoardWeekly  Board = "weekly"
+	BoardAllTime Board = "all_time"
+)
+
+// FirstSeason 終了したseasonが無い場合のseasonの番号.
+const FirstSeason = 1
+
+type (
+	// Board is
+	//   順位表の集計期間. 各順位表にはその期間にranked roomで対局したプレイヤーが最新のratingで載る.
+	//   通算の順位表はseasonの終了で空になる.
+	Board string
+
+	// Entry is
+	//   順位表の1行. Rankは1始まり.
+	Entry struct {
+		Rank     int     `json:"rank"`
+		PlayerID string  `json:"player_id"`
+		Rating   float64 `json:"rating"`
+	}
+
+	// Page is
+	//   順位表の1ページ分. NextPageTokenが空の場合は最後のページ.
+	Page struct {
+		Board         Board   `json:"board"`
+		Season        int     `json:"season"`
+		Entries       []Entry `json:"entries"`
+		NextPageToken string  `json:"next_page_token"`
+	}
+
+	// Season is
+	//   終了したseasonの通算の順位表の最終結果.
+	Season struct {
+		Number    int       `json:"number"`
+		ClosedAt  time.Time `json:"closed_at"`
+		Standings []Entry   `json:"standings"`
+	}
+)
+
+// ParseBoard 集計期間の名前をBoardに変換する. 空文字の場合は通算.
+func ParseBoard(s string) (Board, error) {
+	if s == "" {
+		return BoardAllTime, nil
+	}
+	b := Board(s)
+	if err := b.Validate(); err != nil {
+		return "", err
+	}
+	return b, nil
+}
+
+// Validate 定義された集計期間か検証する.
+func (b Board) Validate() error {
+	switch b {
+	case BoardDaily, BoardWeekly, BoardAllTime:
+		return nil
+	default:
+		return exceptions.NewInvalidArgumentError(fmt.Sprintf("unknown leaderboard: %s", b))
+	}
+}
+
+// Period atを含む集計期間の名前を返す. 通算の場合は空文字.
+func (b Board) Period(at time.Time) string {
+	at = at.UTC()
+	switch b {
+	case BoardDaily:
+		return at.Format("2006-01-02")
+	case BoardWeekly:
+		year, week := at.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	default:
+		return ""
+	}
+}
+
+// Retention 最後の記録から順位表を保持する長さ. 通算の場合は0で、期限なく保持する.
+func (b Board) Retention() time.Duration {
+	switch b {
+	case BoardDaily:
+		return 2 * 24 * time.Hour
+	case BoardWeekly:
+		return 2 * 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// Boards 全ての集計期間.
+func Boards() []Board {
+	return []Board{BoardDaily, BoardWeekly, BoardAllTime}
+}
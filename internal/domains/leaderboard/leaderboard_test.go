@@ -0,0 +1,28 @@
+package leaderboard
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBoard_Period(t *testing.T) {
+	tests := []struct {
+		name  string
+		board Board
+		at    time.Time
+		want  string
+	}{
+		{name: "daily", board: BoardDaily, at: time.Date(2021, 7, 1, 23, 59, 0, 0, time.UTC), want: "2021-07-01"},
+		{name: "daily in another time zone", board: BoardDaily, at: time.Date(2021, 7, 2, 8, 0, 0, 0, time.FixedZone("JST", 9*60*60)), want: "2021-07-01"},
+		{name: "weekly", board: BoardWeekly, at: time.Date(2021, 7, 4, 0, 0, 0, 0, time.UTC), want: "2021-W26"},
+		{name: "weekly across years", board: BoardWeekly, at: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), want: "2020-W53"},
+		{name: "all time", board: BoardAllTime, at: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.board.Period(tt.at); got != tt.want {
+				t.Fatalf("wanted %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -13,4 +13,35 @@ const (
 	PlayerBPicked
 	PlayerAWin
 	PlayerBWin
+	Draw
+	// PlayerADrawOffered PlayerAが引き分けを提案し、PlayerBの回答を待っている.
+	PlayerADrawOffered
+	// PlayerBDrawOffered PlayerBが引き分けを提案し、PlayerAの回答を待っている.
+	PlayerBDrawOffered
+	// PlayerAResigned PlayerAが投了した. PlayerBの勝ち.
+	PlayerAResigned
+	// PlayerBResigned PlayerBが投了した. PlayerAの勝ち.
+	PlayerBResigned
+	// DrawAgreed 両者の合意による引き分け.
+	DrawAgreed
 )
+
+// IsFinished 勝敗または引き分けが決まった状態か.
+func (s State) IsFinished() bool {
+	switch s {
+	case PlayerAWin, PlayerBWin, Draw, PlayerAResigned, PlayerBResigned, DrawAgreed:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDraw 引き分けで終了した状態か.
+func (s State) IsDraw() bool {
+	return s == Draw || s == DrawAgreed
+}
+
+// IsDrawOffered 引き分けの提案に対する回答を待っている状態か.
+func (s State) IsDrawOffered() bool {
+	return s == PlayerADrawOffered || s == PlayerBDrawOffered
+}
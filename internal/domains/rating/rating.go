@@ -0,0 +1,142 @@
+package rating
+
+import (
+	"math"
+	"time"
+)
+
+// Glicko-2の初期値と定数. ratingとRDはGlickoの尺度で保持し、計算時のみGlicko-2の尺度に変換する.
+const (
+	DefaultRating     = 1500.0
+	DefaultRD         = 350.0
+	DefaultVolatility = 0.06
+	// Period RDが増加する期間. 対局の無い期間ごとにRDが増え、DefaultRDで頭打ちとなる.
+	Period = 24 * time.Hour
+
+	// scale Glickoの尺度からGlicko-2の尺度への変換係数.
+	scale = 173.7178
+	// tau volatilityの変化の大きさを制限する.
+	tau = 0.5
+	// epsilon volatilityの反復計算の収束判定.
+	epsilon = 0.000001
+)
+
+type (
+	// Rating is
+	//   プレイヤーのGlicko-2のrating. GamesはUpdateのたびに増え、保存時の競合検知に使用する.
+	Rating struct {
+		PlayerID   string    `json:"player_id"`
+		Rating     float64   `json:"rating"`
+		RD         float64   `json:"rd"`
+		Volatility float64   `json:"volatility"`
+		Games      int       `json:"games"`
+		UpdatedAt  time.Time `json:"updated_at"`
+	}
+
+	// Change is
+	//   1ゲームによるratingの変化.
+	Change struct {
+		Before float64 `json:"before"`
+		After  float64 `json:"after"`
+	}
+
+	// Result is
+	//   ranked roomのゲーム終了による両プレイヤーのratingの変化. 席ごとに記録する.
+	Result struct {
+		PlayerA Change `json:"player_a"`
+		PlayerB Change `json:"player_b"`
+	}
+)
+
+// NewRating 対局の記録が無いプレイヤーの初期のratingを返す.
+func NewRating(playerID string) *Rating {
+	return &Rating{
+		PlayerID:   playerID,
+		Rating:     DefaultRating,
+		RD:         DefaultRD,
+		Volatility: DefaultVolatility,
+	}
+}
+
+// Delta ratingの増減.
+func (c Change) Delta() float64 {
+	return c.After - c.Before
+}
+
+// Decayed 最後の対局からnowまでの対局の無い期間の分だけRDを増やしたratingを返す.
+func (r Rating) Decayed(now time.Time) Rating {
+	if r.UpdatedAt.IsZero() || !now.After(r.UpdatedAt) {
+		return r
+	}
+
+	periods := float64(now.Sub(r.UpdatedAt) / Period)
+	phi := r.RD / scale
+	r.RD = math.Min(math.Sqrt(phi*phi+periods*r.Volatility*r.Volatility)*scale, DefaultRD)
+	return r
+}
+
+// Update aとbがnowに対局した結果のratingを返す. scoreはaから見た結果で、勝ちは1、引き分けは0.5、負けは0.
+//   各ゲームを1つのrating periodとして扱い、対局前の期間のRDの増加も反映する.
+func Update(a, b Rating, score float64, now time.Time) (Rating, Rating) {
+	a, b = a.Decayed(now), b.Decayed(now)
+	return a.update(b, score, now), b.update(a, 1-score, now)
+}
+
+// update opponentとの1ゲームの結果scoreを反映したratingを返す.
+func (r Rating) update(opponent Rating, score float64, now time.Time) Rating {
+	mu, phi := (r.Rating-DefaultRating)/scale, r.RD/scale
+	muJ, phiJ := (opponent.Rating-DefaultRating)/scale, opponent.RD/scale
+
+	g := 1 / math.Sqrt(1+3*phiJ*phiJ/(math.Pi*math.Pi))
+	e := 1 / (1 + math.Exp(-g*(mu-muJ)))
+	v := 1 / (g * g * e * (1 - e))
+	delta := v * g * (score - e)
+
+	sigma := volatility(phi, r.Volatility, v, delta)
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*g*(score-e)
+
+	r.Rating = newMu*scale + DefaultRating
+	r.RD = math.Min(newPhi*scale, DefaultRD)
+	r.Volatility = sigma
+	r.Games++
+	r.UpdatedAt = now
+	return r
+}
+
+// volatility Glicko-2の手順5に従い、Illinois法で新しいvolatilityを求める.
+func volatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(tau*tau)
+	}
+
+	lower := a
+	var upper float64
+	if delta*delta > phi*phi+v {
+		upper = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*tau) < 0 {
+			k++
+		}
+		upper = a - k*tau
+	}
+
+	fLower, fUpper := f(lower), f(upper)
+	for math.Abs(upper-lower) > epsilon {
+		c := lower + (lower-upper)*fLower/(fUpper-fLower)
+		fC := f(c)
+		if fC*fUpper <= 0 {
+			lower, fLower = upper, fUpper
+		} else {
+			fLower /= 2
+		}
+		upper, fUpper = c, fC
+	}
+
+	return math.Exp(lower / 2)
+}
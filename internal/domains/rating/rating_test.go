@@ -0,0 +1,76 @@
+package rating
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestUpdate(t *testing.T) {
+	now := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	rated := func(rating, rd float64) Rating {
+		return Rating{Rating: rating, RD: rd, Volatility: DefaultVolatility, UpdatedAt: now}
+	}
+
+	tests := []struct {
+		name        string
+		a, b        Rating
+		score       float64
+		wantAGain   bool
+		wantBGain   bool
+		wantZeroSum bool
+	}{
+		{name: "win between new players", a: *NewRating("a"), b: *NewRating("b"), score: 1, wantAGain: true, wantZeroSum: true},
+		{name: "loss between new players", a: *NewRating("a"), b: *NewRating("b"), score: 0, wantBGain: true, wantZeroSum: true},
+		{name: "upset", a: rated(1400, 80), b: rated(1800, 80), score: 1, wantAGain: true},
+		{name: "draw against stronger", a: rated(1400, 80), b: rated(1800, 80), score: 0.5, wantAGain: true},
+		{name: "expected win", a: rated(1800, 80), b: rated(1400, 80), score: 1, wantAGain: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := Update(tt.a, tt.b, tt.score, now)
+
+			if got := a.Rating > tt.a.Rating; got != tt.wantAGain {
+				t.Fatalf("unexpected rating of a: %f -> %f", tt.a.Rating, a.Rating)
+			}
+			if got := b.Rating > tt.b.Rating; got != tt.wantBGain {
+				t.Fatalf("unexpected rating of b: %f -> %f", tt.b.Rating, b.Rating)
+			}
+			if tt.wantZeroSum && math.Abs((a.Rating-tt.a.Rating)+(b.Rating-tt.b.Rating)) > 1e-9 {
+				t.Fatalf("wanted zero-sum changes but got %f and %f", a.Rating-tt.a.Rating, b.Rating-tt.b.Rating)
+			}
+			if a.RD >= tt.a.RD || b.RD >= tt.b.RD {
+				t.Fatalf("wanted rd to shrink but got %f and %f", a.RD, b.RD)
+			}
+			if a.Games != tt.a.Games+1 || !a.UpdatedAt.Equal(now) {
+				t.Fatalf("unexpected games %d or updated at %s", a.Games, a.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestRating_Decayed(t *testing.T) {
+	last := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	r := Rating{Rating: 1600, RD: 50, Volatility: DefaultVolatility, UpdatedAt: last}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want float64
+	}{
+		{name: "within a period", now: last.Add(Period - time.Second), want: 50},
+		{name: "30 periods", now: last.Add(30 * Period), want: math.Sqrt(50*50 + 30*DefaultVolatility*DefaultVolatility*scale*scale)},
+		{name: "capped", now: last.Add(100000 * Period), want: DefaultRD},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := r.Decayed(tt.now)
+			if math.Abs(got.RD-tt.want) > 1e-9 {
+				t.Fatalf("wanted rd %f but got %f", tt.want, got.RD)
+			}
+			if got.Rating != r.Rating || !got.UpdatedAt.Equal(last) {
+				t.Fatalf("wanted only rd to change but got %+v", got)
+			}
+		})
+	}
+}
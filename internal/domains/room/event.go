@@ -0,0 +1,19 @@
+package room
+
+type (
+	EventType int
+
+	// Event is
+	//   roomで発生した変更の通知. 内容はstreamやmemberから読み込むこと.
+	Event struct {
+		Type    EventType `json:"type"`
+		LoginID string    `json:"login_id,omitempty"`
+	}
+)
+
+const (
+	EventBattleUpdated EventType = iota + 1
+	EventMemberEntered
+	EventMemberLeft
+	EventClosed
+)
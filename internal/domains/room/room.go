@@ -12,6 +12,7 @@ const (
 	idKeyPrefix     = "tic_tac_toe_id"
 	memberKeyPrefix = "tic_tac_toe_member"
 	streamKeyPrefix = "tic_tac_toe_stream"
+	eventKeyPrefix  = "tic_tac_toe_event"
 )
 
 const (
@@ -38,6 +39,10 @@ func (id ID) StreamKey() string {
 	return fmt.Sprintf("%s:%s", streamKeyPrefix, id)
 }
 
+func (id ID) EventKey() string {
+	return fmt.Sprintf("%s:%s", eventKeyPrefix, id)
+}
+
 func (id ID) String() string {
 	return string(id)
 }
@@ -0,0 +1,255 @@
+package tablebase
+
+import (
+	"math"
+	"sort"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// 着手で勝敗が決まった場合のchildren. 0以上は着手後の局面のindex.
+	childWin  int32 = -1
+	childLoss int32 = -2
+	childDraw int32 = -3
+)
+
+type (
+	// edge 着手後の局面. 着手で勝敗が決まった場合はresultに着手したプレイヤーから見た結末を持つ.
+	edge struct {
+		key      Key
+		terminal bool
+		result   Result
+	}
+
+	// generator 局面を列挙した順のindexで扱い、Keyの昇順への並べ替えは最後に行う.
+	generator struct {
+		rule    battle.Rule
+		keys    []Key
+		results []Result
+		// offsets keys[i]の着手後の局面はchildren[offsets[i]:offsets[i+1]].
+		offsets  []int
+		children []int32
+	}
+
+	// byKey keysとresultsをKeyの昇順に並べ替える.
+	byKey generator
+)
+
+// Generate rootから到達できる全ての局面をruleに従って列挙し、Resultを求める.
+//   千日手や手数制限による引き分けは考慮しない. logfには進捗を出力する. nilの場合は出力しない.
+//   各局面の展開は1回のみで、着手後の局面は列挙時にindexで記録する. 必要なメモリは局面ごとに約50byte、
+//   着手ごとに8byteで、3x3の全局面の生成にかかる時間とメモリはREADMEに記載する.
+func Generate(r battle.Rule, root *battle.Battle, logf func(format string, args ...interface{})) (*Tablebase, error) {
+	if logf == nil {
+		logf = func(string, ...interface{}) {}
+	}
+
+	rootKey, ok := Encode(root)
+	if !ok {
+		return nil, xerrors.New("root is not encodable")
+	}
+
+	g := &generator{rule: r}
+	if err := g.enumerate(rootKey, logf); err != nil {
+		return nil, xerrors.Errorf("failed to enumerate: %w", err)
+	}
+	if err := g.solve(logf); err != nil {
+		return nil, xerrors.Errorf("failed to solve: %w", err)
+	}
+	sort.Sort((*byKey)(g))
+
+	return &Tablebase{keys: g.keys, results: g.results}, nil
+}
+
+// enumerate rootから到達できる勝敗の決まっていない局面を幅優先で列挙し、着手後の局面を記録する.
+func (g *generator) enumerate(root Key, logf func(format string, args ...interface{})) error {
+	indexes := map[Key]int32{root: 0}
+	g.keys = []Key{root}
+
+	var edges []edge
+	for depth, i := 1, 0; i < len(g.keys); depth++ {
+		// keys[i:end]が同じ深さの局面
+		for end := len(g.keys); i < end; i++ {
+			g.offsets = append(g.offsets, len(g.children))
+			edges = g.expand(g.keys[i], edges[:0])
+			for _, e := range edges {
+				switch {
+				case !e.terminal:
+					c, ok := indexes[e.key]
+					if !ok {
+						if len(g.keys) == math.MaxInt32 {
+							return xerrors.Errorf("too many positions: %d", len(g.keys))
+						}
+						c = int32(len(g.keys))
+						indexes[e.key] = c
+						g.keys = append(g.keys, e.key)
+					}
+					g.children = append(g.children, c)
+				case e.result.IsWin():
+					g.children = append(g.children, childWin)
+				case e.result.IsLoss():
+					g.children = append(g.children, childLoss)
+				default:
+					g.children = append(g.children, childDraw)
+				}
+			}
+		}
+		logf("enumerate: depth %d, %d positions, %d moves", depth, len(g.keys), len(g.children))
+	}
+	g.offsets = append(g.offsets, len(g.children))
+	g.results = make([]Result, len(g.keys))
+
+	return nil
+}
+
+// solve 終局から遡ってn手で勝敗の決まる局面をn=1から順に確定させる. 最後まで確定しなかった局面は引き分けとなる.
+//   n手目に確定するのはn-1手目に確定した局面の1手前の局面のみのため、手数は最短(負けの場合は最長)となる.
+func (g *generator) solve(logf func(format string, args ...interface{})) error {
+	var (
+		solved = make([]bool, len(g.keys))
+		// pending 負けと確定していない着手後の局面の数. 0になった局面は全ての着手で負ける.
+		//   着手で引き分けとなる局面は負けと確定しないため-1とする.
+		pending = make([]int32, len(g.keys))
+		// parents 着手後の局面から着手前の局面への逆引き. keys[i]の着手前の局面はparents[parentOffsets[i]:parentOffsets[i+1]].
+		parentOffsets = make([]int, len(g.keys)+1)
+		parents       = make([]int32, 0, len(g.children))
+		frontier      []int32
+	)
+
+	// 1手で勝敗が決まる局面
+	for i := range g.keys {
+		children := g.children[g.offsets[i]:g.offsets[i+1]]
+		win, draw := false, false
+		for _, c := range children {
+			switch {
+			case c >= 0:
+				parentOffsets[c+1]++
+				pending[i]++
+			case c == childWin:
+				win = true
+			case c == childDraw:
+				draw = true
+			}
+		}
+
+		switch {
+		case win:
+			g.results[i] = 1
+		case len(children) > 0 && pending[i] == 0 && !draw:
+			g.results[i] = -1
+		default:
+			if draw {
+				pending[i] = -1
+			}
+			continue
+		}
+		solved[i] = true
+		frontier = append(frontier, int32(i))
+	}
+	logf("solve: 1 plies, %d positions", len(frontier))
+
+	for i := 1; i < len(parentOffsets); i++ {
+		parentOffsets[i] += parentOffsets[i-1]
+	}
+	parents = parents[:len(g.children)-countTerminal(g.children)]
+	next := append([]int(nil), parentOffsets[:len(g.keys)]...)
+	for i := range g.keys {
+		for _, c := range g.children[g.offsets[i]:g.offsets[i+1]] {
+			if c >= 0 {
+				parents[next[c]] = int32(i)
+				next[c]++
+			}
+		}
+	}
+
+	for n := 2; len(frontier) > 0; n++ {
+		if n > math.MaxInt8 {
+			return xerrors.Errorf("result exceeds %d plies", math.MaxInt8)
+		}
+
+		var solving []int32
+		for _, c := range frontier {
+			lost := g.results[c].IsLoss()
+			for _, p := range parents[parentOffsets[c]:parentOffsets[c+1]] {
+				if solved[p] {
+					continue
+				}
+				switch {
+				case lost:
+					// 相手を負けの局面にする着手がある
+					g.results[p] = Result(n)
+				case pending[p] > 0:
+					// 最後に負けと確定した着手後の局面が最長の負けとなる
+					if pending[p]--; pending[p] > 0 {
+						continue
+					}
+					g.results[p] = Result(-n)
+				default:
+					continue
+				}
+				solved[p] = true
+				solving = append(solving, p)
+			}
+		}
+
+		frontier = solving
+		logf("solve: %d plies, %d positions", n, len(frontier))
+	}
+
+	return nil
+}
+
+// countTerminal 着手で勝敗が決まったchildrenの数.
+func countTerminal(children []int32) int {
+	n := 0
+	for _, c := range children {
+		if c < 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *byKey) Len() int {
+	return len(s.keys)
+}
+
+func (s *byKey) Less(i, j int) bool {
+	return s.keys[i] < s.keys[j]
+}
+
+func (s *byKey) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.results[i], s.results[j] = s.results[j], s.results[i]
+}
+
+// expand kの局面で手番のプレイヤーが取り得る全ての着手の結果をedgesに追加する.
+func (g *generator) expand(k Key, edges []edge) []edge {
+	b := decode(g.rule, k)
+	player := b.Turn()
+	for _, a := range g.rule.LegalMoves(b, player) {
+		c := b.Clone()
+		if err := battle.Play(g.rule, c, player, a); err != nil {
+			continue
+		}
+
+		switch {
+		case !c.State.IsFinished():
+			ck, ok := Encode(c)
+			if !ok {
+				continue
+			}
+			edges = append(edges, edge{key: ck})
+		case c.Winner() == player:
+			edges = append(edges, edge{terminal: true, result: 1})
+		case c.Winner() != tictactoe_battle.Player_PLAYER_UNKNOWN:
+			edges = append(edges, edge{terminal: true, result: -1})
+		default:
+			edges = append(edges, edge{terminal: true})
+		}
+	}
+	return edges
+}
@@ -0,0 +1,106 @@
+package tablebase
+
+import (
+	"math"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+)
+
+const (
+	fieldSize = 9
+	// maxHolding 1種類の駒の最大数. 持ち駒の数は3進数で記録する.
+	maxHolding = 2
+
+	// cellBits 1マスのbit数. 小さい駒から順に2bitずつ持ち主を記録する.
+	cellBits     = 6
+	holdingShift = fieldSize * cellBits
+
+	ownerNone     = 0
+	ownerMover    = 1
+	ownerOpponent = 2
+)
+
+type (
+	// Key is
+	//   手番開始時の局面. 手番のプレイヤーを基準に記録するため、AとBを入れ替えた局面は同じKeyとなる.
+	//   盤面を回転、反転した局面のうち最小のものを記録するため、対称な局面も同じKeyとなる.
+	//   下位54bitが盤面、上位10bitが両者の持ち駒.
+	Key uint64
+)
+
+// Encode bの局面のKeyを返す. 手番開始時でない局面、標準以外のルール、同じ大きさの持ち駒が3個以上の局面はfalseを返す.
+func Encode(b *battle.Battle) (Key, bool) {
+	mover := b.Turn()
+	if mover == tictactoe_battle.Player_PLAYER_UNKNOWN || b.PickedPosition != tictactoe_battle.Position_POSITION_UNDEFINED ||
+		len(b.Field) != fieldSize || !b.RuleSet.IsDefault() {
+		return 0, false
+	}
+
+	var cells [fieldSize]Key
+	for i, stack := range b.Field {
+		for s, owner := range [3]tictactoe_battle.Player{stack.S, stack.M, stack.L} {
+			switch owner {
+			case tictactoe_battle.Player_PLAYER_UNKNOWN:
+			case mover:
+				cells[i] |= ownerMover << (s * 2)
+			default:
+				cells[i] |= ownerOpponent << (s * 2)
+			}
+		}
+	}
+	k := Key(math.MaxUint64)
+	for _, perm := range battle.Symmetries(fieldSize) {
+		var moved Key
+		for i, cell := range cells {
+			moved |= cell << (perm[i] * cellBits)
+		}
+		if moved < k {
+			k = moved
+		}
+	}
+
+	moverHolding, opponentHolding := b.PlayerAHolding, b.PlayerBHolding
+	if mover == tictactoe_battle.Player_PLAYER_B {
+		moverHolding, opponentHolding = opponentHolding, moverHolding
+	}
+	var holding Key
+	for _, h := range []*tictactoe_battle.Holding{opponentHolding, moverHolding} {
+		for _, n := range []uint64{h.L, h.M, h.S} {
+			if n > maxHolding {
+				return 0, false
+			}
+			holding = holding*(maxHolding+1) + Key(n)
+		}
+	}
+
+	return k | holding<<holdingShift, true
+}
+
+// decode kの局面をPLAYER_Aの手番として復元する.
+func decode(r battle.Rule, k Key) *battle.Battle {
+	b := r.OpenBattle(battle.DefaultRuleSet())
+	b.State = management_state.PlayerATurn
+
+	for i, stack := range b.Field {
+		for s, owner := range []*tictactoe_battle.Player{&stack.S, &stack.M, &stack.L} {
+			switch (k >> (i*cellBits + s*2)) & 3 {
+			case ownerMover:
+				*owner = tictactoe_battle.Player_PLAYER_A
+			case ownerOpponent:
+				*owner = tictactoe_battle.Player_PLAYER_B
+			}
+		}
+	}
+
+	holding := k >> holdingShift
+	for _, h := range []*tictactoe_battle.Holding{b.PlayerAHolding, b.PlayerBHolding} {
+		for _, n := range []*uint64{&h.S, &h.M, &h.L} {
+			*n = uint64(holding % (maxHolding + 1))
+			holding /= maxHolding + 1
+		}
+	}
+
+	return b
+}
@@ -0,0 +1,220 @@
+package tablebase
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// version Keyの形式を変更した場合は上げる.
+	version = 2
+)
+
+// magic tablebaseファイルの先頭に書き込む識別子.
+var magic = [4]byte{'T', 'T', 'T', 'B'}
+
+type (
+	// Result is
+	//   手番のプレイヤーから見た局面の結末. 正は勝ち、負は負け、0は引き分け.
+	//   絶対値は双方が最善を尽くした場合に勝敗が決まるまでの手数(ply).
+	Result int8
+
+	// Tablebase is
+	//   局面ごとのResultを記録した表. Keyの昇順に並べて二分探索する.
+	Tablebase struct {
+		keys    []Key
+		results []Result
+	}
+)
+
+func (r Result) IsWin() bool {
+	return r > 0
+}
+
+func (r Result) IsLoss() bool {
+	return r < 0
+}
+
+// Plies 勝敗が決まるまでの手数. 引き分けの場合は0.
+func (r Result) Plies() int {
+	if r < 0 {
+		return int(-r)
+	}
+	return int(r)
+}
+
+// previous 1手前に着手したプレイヤーから見たResult.
+func (r Result) previous() Result {
+	switch {
+	case r > 0:
+		return -(r + 1)
+	case r < 0:
+		return -r + 1
+	default:
+		return 0
+	}
+}
+
+// rank Resultの良さ. 早く勝てるほど、遅く負けるほど高い.
+func (r Result) rank() int {
+	switch {
+	case r > 0:
+		return 1000 - int(r)
+	case r < 0:
+		return -1000 - int(r)
+	default:
+		return 0
+	}
+}
+
+// Len 記録している局面の数.
+func (t *Tablebase) Len() int {
+	return len(t.keys)
+}
+
+// Lookup bの局面のResultを返す. 記録されていない局面の場合はfalse.
+func (t *Tablebase) Lookup(b *battle.Battle) (Result, bool) {
+	k, ok := Encode(b)
+	if !ok {
+		return 0, false
+	}
+	return t.lookup(k)
+}
+
+func (t *Tablebase) lookup(k Key) (Result, bool) {
+	i := sort.Search(len(t.keys), func(i int) bool { return t.keys[i] >= k })
+	if i == len(t.keys) || t.keys[i] != k {
+		return 0, false
+	}
+	return t.results[i], true
+}
+
+// Best bの手番のプレイヤーの最善手とその結末を返す. 駒を持ち上げている局面も扱える.
+//   着手後の局面が記録されていない場合はfalse. bは変更しない.
+func (t *Tablebase) Best(r battle.Rule, b *battle.Battle) (battle.Action, Result, bool) {
+	player := b.Turn()
+	if player == tictactoe_battle.Player_PLAYER_UNKNOWN {
+		return battle.Action{}, 0, false
+	}
+
+	var (
+		best       battle.Action
+		bestResult Result
+		found      bool
+	)
+	for _, a := range r.LegalMoves(b, player) {
+		c := b.Clone()
+		if err := battle.Play(r, c, player, a); err != nil {
+			return battle.Action{}, 0, false
+		}
+
+		var result Result
+		switch {
+		case !c.State.IsFinished():
+			child, ok := t.Lookup(c)
+			if !ok {
+				return battle.Action{}, 0, false
+			}
+			result = child.previous()
+		case c.Winner() == player:
+			result = 1
+		case c.Winner() != tictactoe_battle.Player_PLAYER_UNKNOWN:
+			result = -1
+		}
+
+		if !found || result.rank() > bestResult.rank() {
+			best, bestResult, found = a, result, true
+		}
+	}
+
+	return best, bestResult, found
+}
+
+// Load pathのtablebaseファイルを読み込む.
+func Load(path string) (*Tablebase, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to open tablebase: %w", err)
+	}
+	defer f.Close()
+
+	t, err := Read(f)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Read: %w", err)
+	}
+	return t, nil
+}
+
+// Read Writeで書き込んだtablebaseを読み込む.
+func Read(r io.Reader) (*Tablebase, error) {
+	br := bufio.NewReader(r)
+
+	var header [len(magic) + 1]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		return nil, xerrors.Errorf("failed to read header: %w", err)
+	}
+	if [len(magic)]byte{header[0], header[1], header[2], header[3]} != magic || header[len(magic)] != version {
+		return nil, xerrors.New("not a tablebase file")
+	}
+
+	count, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read count: %w", err)
+	}
+
+	t := &Tablebase{
+		keys:    make([]Key, count),
+		results: make([]Result, count),
+	}
+	var k Key
+	for i := range t.keys {
+		delta, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read key: %w", err)
+		}
+		result, err := br.ReadByte()
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read result: %w", err)
+		}
+		k += Key(delta)
+		t.keys[i], t.results[i] = k, Result(result)
+	}
+
+	return t, nil
+}
+
+// Write tablebaseを書き込む. Keyは直前のKeyとの差分として可変長で記録する.
+func (t *Tablebase) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	if _, err := bw.Write(append(magic[:], version)); err != nil {
+		return xerrors.Errorf("failed to write header: %w", err)
+	}
+
+	buf := make([]byte, binary.MaxVarintLen64+1)
+	if _, err := bw.Write(buf[:binary.PutUvarint(buf, uint64(len(t.keys)))]); err != nil {
+		return xerrors.Errorf("failed to write count: %w", err)
+	}
+
+	var prev Key
+	for i, k := range t.keys {
+		n := binary.PutUvarint(buf, uint64(k-prev))
+		buf[n] = byte(t.results[i])
+		if _, err := bw.Write(buf[:n+1]); err != nil {
+			return xerrors.Errorf("failed to write entry: %w", err)
+		}
+		prev = k
+	}
+
+	if err := bw.Flush(); err != nil {
+		return xerrors.Errorf("failed to Flush: %w", err)
+	}
+	return nil
+}
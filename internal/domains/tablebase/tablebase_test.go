@@ -0,0 +1,133 @@
+package tablebase
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+)
+
+// newRoot 持ち駒をAは大2個と中1個、Bは大中1個ずつに減らした局面. 全局面を列挙してもテストで扱える規模となる.
+func newRoot(t *testing.T, r battle.Rule) *battle.Battle {
+	t.Helper()
+
+	b := r.OpenBattle(battle.DefaultRuleSet())
+	for _, id := range []string{"player_a", "player_b"} {
+		if err := r.Declaration(b, id); err != nil {
+			t.Fatalf("failed to Declaration: %v", err)
+		}
+	}
+	b.PlayerAHolding = &tictactoe_battle.Holding{M: 1, L: 2}
+	b.PlayerBHolding = &tictactoe_battle.Holding{M: 1, L: 1}
+	return b
+}
+
+func TestGenerate(t *testing.T) {
+	r := battle.NewRule()
+	tb, err := Generate(r, newRoot(t, r), t.Logf)
+	if err != nil {
+		t.Fatalf("failed to Generate: %v", err)
+	}
+
+	t.Run("every result agrees with the best move", func(t *testing.T) {
+		for i, k := range tb.keys {
+			_, got, ok := tb.Best(r, decode(r, k))
+			if !ok {
+				t.Fatalf("failed to Best: %x", k)
+			}
+			if want := tb.results[i]; got != want {
+				t.Fatalf("%x: wanted %d but got %d", k, want, got)
+			}
+		}
+	})
+
+	t.Run("colors are normalized", func(t *testing.T) {
+		b := newRoot(t, r)
+		if err := r.Attack(b, tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_M); err != nil {
+			t.Fatalf("failed to Attack: %v", err)
+		}
+		got, ok := tb.Lookup(b)
+		if !ok {
+			t.Fatalf("position not found")
+		}
+		// Bの手番の局面はAとBを入れ替えてAの手番とした局面と同じ
+		k, _ := Encode(b)
+		swapped := decode(r, k)
+		if want, _ := tb.Lookup(swapped); got != want {
+			t.Fatalf("wanted %d but got %d", want, got)
+		}
+	})
+
+	t.Run("symmetric positions share the key", func(t *testing.T) {
+		var keys []Key
+		for _, pos := range []tictactoe_battle.Position{
+			tictactoe_battle.Position_POSITION_X0Y0,
+			tictactoe_battle.Position_POSITION_X2Y0,
+			tictactoe_battle.Position_POSITION_X0Y2,
+			tictactoe_battle.Position_POSITION_X2Y2,
+		} {
+			b := newRoot(t, r)
+			if err := r.Attack(b, tictactoe_battle.Player_PLAYER_A, pos, tictactoe_battle.Piece_PIECE_L); err != nil {
+				t.Fatalf("failed to Attack: %v", err)
+			}
+			k, _ := Encode(b)
+			keys = append(keys, k)
+		}
+		for _, k := range keys[1:] {
+			if k != keys[0] {
+				t.Fatalf("wanted %x but got %x", keys[0], k)
+			}
+		}
+	})
+
+	t.Run("takes the winning move", func(t *testing.T) {
+		// A A -
+		// B B -
+		// - - -
+		b := newRoot(t, r)
+		for _, m := range []struct {
+			player tictactoe_battle.Player
+			pos    tictactoe_battle.Position
+			size   tictactoe_battle.Piece
+		}{
+			{tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L},
+			{tictactoe_battle.Player_PLAYER_B, tictactoe_battle.Position_POSITION_X0Y1, tictactoe_battle.Piece_PIECE_L},
+			{tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X1Y0, tictactoe_battle.Piece_PIECE_M},
+			{tictactoe_battle.Player_PLAYER_B, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_M},
+		} {
+			if err := r.Attack(b, m.player, m.pos, m.size); err != nil {
+				t.Fatalf("failed to Attack: %v", err)
+			}
+		}
+
+		a, result, ok := tb.Best(r, b)
+		if !ok {
+			t.Fatalf("failed to Best")
+		}
+		if result != 1 {
+			t.Fatalf("wanted win in 1 ply but got %d", result)
+		}
+		if err := battle.Play(r, b, tictactoe_battle.Player_PLAYER_A, a); err != nil {
+			t.Fatalf("failed to Play: %v", err)
+		}
+		if b.Winner() != tictactoe_battle.Player_PLAYER_A {
+			t.Fatalf("wanted player a win by %+v but got %d", a, b.State)
+		}
+	})
+
+	t.Run("Write and Read", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := tb.Write(&buf); err != nil {
+			t.Fatalf("failed to Write: %v", err)
+		}
+		got, err := Read(&buf)
+		if err != nil {
+			t.Fatalf("failed to Read: %v", err)
+		}
+		if diff := cmp.Diff(tb, got, cmp.AllowUnexported(Tablebase{})); diff != "" {
+			t.Fatalf("(-want +got)\n%s", diff)
+		}
+	})
+}
@@ -6,17 +6,24 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/mode"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/redis"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/sqlite"
 )
 
 var (
 	Server Config
 	Redis  redis.Config
+	SQLite sqlite.Config
 )
 
 type (
 	Config struct {
-		RunMode mode.Mode `envconfig:"run_mode" default:"debug"`
-		PORT    string    `envconfig:"grpc_port" default:"50051"`
+		RunMode     mode.Mode `envconfig:"run_mode" default:"debug"`
+		PORT        string    `envconfig:"grpc_port" default:"50051"`
+		MemDBDriver string    `envconfig:"memdb_driver" default:"redis"`
+		// TablebasePath 起動時に読み込むtablebaseファイル. 空の場合は読み込まない.
+		TablebasePath string `envconfig:"tablebase_path"`
+		// AdminToken 管理者用のRPCを認証するtoken. 空の場合は管理者用のRPCを呼び出せない.
+		AdminToken string `envconfig:"admin_token"`
 	}
 )
 
@@ -27,6 +34,7 @@ func init() {
 func setup() {
 	check(envconfig.Process("", &Server))
 	check(envconfig.Process("redis", &Redis))
+	check(envconfig.Process("sqlite", &SQLite))
 }
 
 func check(err error) {
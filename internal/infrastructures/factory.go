@@ -1,23 +1,59 @@
 package infrastructures
 
 import (
+	"fmt"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/env"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/memory"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/redis"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/sqlite"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
 )
 
+const (
+	memDBDriverRedis  = "redis"
+	memDBDriverMemory = "memory"
+)
+
 type (
 	factory struct {
-		redisClient gateways.MemDBClient
+		memDBClient gateways.MemDBClient
+		sqlClient   gateways.SQLClient
 	}
 )
 
 func NewFactory() gateways.Factory {
 	return &factory{
-		redisClient: redis.NewRedisClient(env.Redis),
+		memDBClient: newMemDBClient(env.Server.MemDBDriver),
+		sqlClient:   newSQLClient(),
 	}
 }
 
 func (f factory) MemDBClient() gateways.MemDBClient {
-	return f.redisClient
+	return f.memDBClient
+}
+
+func (f factory) SQLClient() gateways.SQLClient {
+	return f.sqlClient
+}
+
+func newMemDBClient(driver string) gateways.MemDBClient {
+	switch driver {
+	case memDBDriverRedis:
+		return redis.NewRedisClient(env.Redis)
+	case memDBDriverMemory:
+		return memory.NewMemoryClient()
+	default:
+		exceptions.Panic(fmt.Sprintf("unknown memdb driver: %s", driver))
+		return nil
+	}
+}
+
+func newSQLClient() gateways.SQLClient {
+	cli, err := sqlite.NewSQLiteClient(env.SQLite)
+	if err != nil {
+		exceptions.PanicWithError("failed to open sqlite", err)
+	}
+	return cli
 }
@@ -2,21 +2,28 @@ package grpc_server
 
 import (
 	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
 	"google.golang.org/grpc"
 )
 
 type (
 	controllerRegister struct {
-		ticTacToeBattleController tictactoe_battle.TicTacToeBattleServiceServer
+		ticTacToeBattleController    tictactoe_battle.TicTacToeBattleServiceServer
+		ticTacToeBattleExtController tictactoe_battle_ext.TicTacToeBattleExtServiceServer
 	}
 )
 
-func NewControllerRegister(controller tictactoe_battle.TicTacToeBattleServiceServer) ControllerRegister {
+func NewControllerRegister(
+	controller tictactoe_battle.TicTacToeBattleServiceServer,
+	extController tictactoe_battle_ext.TicTacToeBattleExtServiceServer,
+) ControllerRegister {
 	return &controllerRegister{
-		ticTacToeBattleController: controller,
+		ticTacToeBattleController:    controller,
+		ticTacToeBattleExtController: extController,
 	}
 }
 
 func (cr *controllerRegister) Register(grpcServer grpc.ServiceRegistrar) {
 	tictactoe_battle.RegisterTicTacToeBattleServiceServer(grpcServer, cr.ticTacToeBattleController)
+	tictactoe_battle_ext.RegisterTicTacToeBattleExtServiceServer(grpcServer, cr.ticTacToeBattleExtController)
 }
@@ -11,14 +11,17 @@ import (
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/mode"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 type (
@@ -30,6 +33,11 @@ type (
 		Register(grpcServer grpc.ServiceRegistrar)
 	}
 
+	Interceptor interface {
+		UnaryServerInterceptor() grpc.UnaryServerInterceptor
+		StreamServerInterceptor() grpc.StreamServerInterceptor
+	}
+
 	InitFunc   func()
 	CloserFunc func()
 
@@ -38,6 +46,7 @@ type (
 		port               string
 		mode               mode.Mode
 		controllerRegister ControllerRegister
+		interceptors       []Interceptor
 		initFunction       InitFunc
 		closerFunction     CloserFunc
 	}
@@ -56,6 +65,7 @@ func NewGRPCServer(
 	logger *zap.Logger,
 	port string, mode mode.Mode,
 	controllerRegister ControllerRegister,
+	interceptors []Interceptor,
 	initFunction InitFunc,
 	closerFunction CloserFunc,
 ) GRPCServer {
@@ -64,6 +74,7 @@ func NewGRPCServer(
 		port:               port,
 		mode:               mode,
 		controllerRegister: controllerRegister,
+		interceptors:       interceptors,
 		initFunction:       initFunction,
 		closerFunction:     closerFunction,
 	}
@@ -88,7 +99,7 @@ func (g *grpcServer) RunGRPCServer(ctx context.Context) {
 		}
 	}()
 
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, catchSignals...)
 
 	select {
@@ -142,20 +153,27 @@ func (g *grpcServer) newServer() *grpc.Server {
 		}
 	)
 
-	// TODO: add interceptors(ex: auth)
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		grpc_zap.UnaryServerInterceptor(g.logger, zapOpts...),
+		grpc_auth.UnaryServerInterceptor(newAuthFunc(g.mode)),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		grpc_zap.StreamServerInterceptor(g.logger, zapOpts...),
+		grpc_auth.StreamServerInterceptor(newAuthFunc(g.mode)),
+	}
+	for _, i := range g.interceptors {
+		unaryInterceptors = append(unaryInterceptors, i.UnaryServerInterceptor())
+		streamInterceptors = append(streamInterceptors, i.StreamServerInterceptor())
+	}
 
 	// Make sure that log statements internal to gRPC library are logged using the zapLogger as well.
 	grpc_zap.ReplaceGrpcLoggerV2(g.logger)
 	// Create a server, make sure we put the grpc_ctxtags context before everything else.
 	server := grpc.NewServer(
-		grpc_middleware.WithUnaryServerChain(
-			grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_zap.UnaryServerInterceptor(g.logger, zapOpts...),
-		),
-		grpc_middleware.WithStreamServerChain(
-			grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_zap.StreamServerInterceptor(g.logger, zapOpts...),
-		),
+		grpc_middleware.WithUnaryServerChain(unaryInterceptors...),
+		grpc_middleware.WithStreamServerChain(streamInterceptors...),
 		//grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 	)
@@ -167,3 +185,14 @@ func (g *grpcServer) newServer() *grpc.Server {
 	}
 	return server
 }
+
+// newAuthFunc AuthFuncOverrideを実装していないサービスの認証. 認証は各サービスのAuthFuncOverrideで行うため、
+//   実装していないサービスはdebug時(reflection)のみ通過させる.
+func newAuthFunc(m mode.Mode) grpc_auth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		if m == mode.Debug {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "authentication is not supported")
+	}
+}
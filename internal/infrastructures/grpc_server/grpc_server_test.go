@@ -6,17 +6,27 @@ import (
 	"testing"
 	"time"
 
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/mode"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type (
 	fakeRegister struct{}
+
+	// overrideService AuthFuncOverrideを実装したサービス. 常に認証に成功する.
+	overrideService struct{}
 )
 
 func (f fakeRegister) Register(grpc.ServiceRegistrar) {}
 
+func (s overrideService) AuthFuncOverride(ctx context.Context, _ string) (context.Context, error) {
+	return ctx, nil
+}
+
 func TestGrpcServer_RunGRPCServer(t *testing.T) {
 	ctx := context.Background()
 
@@ -24,7 +34,7 @@ func TestGrpcServer_RunGRPCServer(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to new zap logger: %v", err)
 	}
-	srv := NewGRPCServer(zapLogger, "18080", mode.Debug, fakeRegister{}, func() {}, func() {})
+	srv := NewGRPCServer(zapLogger, "18080", mode.Debug, fakeRegister{}, nil, func() {}, func() {})
 
 	ctx2, cancel := context.WithTimeout(ctx, 1*time.Second)
 	defer cancel()
@@ -37,3 +47,32 @@ func TestGrpcServer_RunGRPCServer(t *testing.T) {
 	}()
 	wg.Wait()
 }
+
+func TestNewAuthFunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     mode.Mode
+		server   interface{}
+		wantCode codes.Code
+	}{
+		{name: "unknown service in debug", mode: mode.Debug, server: struct{}{}, wantCode: codes.OK},
+		{name: "unknown service in test", mode: mode.Test, server: struct{}{}, wantCode: codes.Unauthenticated},
+		{name: "unknown service in release", mode: mode.Release, server: struct{}{}, wantCode: codes.Unauthenticated},
+		{name: "service with override in release", mode: mode.Release, server: overrideService{}, wantCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := grpc_auth.UnaryServerInterceptor(newAuthFunc(tt.mode))
+			info := &grpc.UnaryServerInfo{Server: tt.server, FullMethod: "/unknown.Service/Method"}
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+
+			_, err := interceptor(context.Background(), nil, info, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("wanted %s but got %s", tt.wantCode, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,549 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// subscribeDuration ReadStreamで新しいmessageを待機する時間. redisClientと合わせる.
+	subscribeDuration = 3 * time.Second
+	// subscriptionBufferSize 購読者ごとに保持する未受信messageの数. 超えた場合は破棄する.
+	subscriptionBufferSize = 100
+	// sweepInterval 期限切れのkeyを削除する間隔.
+	sweepInterval = time.Minute
+)
+
+type (
+	// memoryClient is
+	//   プロセス内で完結するMemDBClientの実装. 単一ノードでの起動やテストで使用する.
+	memoryClient struct {
+		mu            sync.Mutex
+		entries       map[string]*entry
+		subscriptions map[string]map[chan string]struct{}
+		// streamUpdated streamへの追加ごとにcloseして作り直す. ReadStreamの待機に使用する.
+		streamUpdated chan struct{}
+		lastSweep     time.Time
+	}
+
+	entry struct {
+		// value string, set, zset, *stream のいずれか
+		value    interface{}
+		expireAt time.Time
+	}
+
+	set map[string]struct{}
+
+	// zset memberごとのscore. 順序は参照のたびに求める.
+	zset map[string]float64
+
+	stream struct {
+		messages []streamMessage
+		lastMs   int64
+		lastSeq  int64
+	}
+
+	streamMessage struct {
+		id     streamID
+		values map[string]string
+	}
+
+	streamID struct {
+		ms  int64
+		seq int64
+	}
+)
+
+func NewMemoryClient() gateways.MemDBClient {
+	return &memoryClient{
+		entries:       make(map[string]*entry),
+		subscriptions: make(map[string]map[chan string]struct{}),
+		streamUpdated: make(chan struct{}),
+		lastSweep:     time.Now(),
+	}
+}
+
+func (c *memoryClient) Ping(_ context.Context) error {
+	return nil
+}
+
+func (c *memoryClient) Set(_ context.Context, key string, value interface{}, duration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.put(key, toString(value), duration)
+	return nil
+}
+
+func (c *memoryClient) SetNX(_ context.Context, key string, value interface{}, duration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lookup(key) != nil {
+		return nil
+	}
+	c.put(key, toString(value), duration)
+	return nil
+}
+
+func (c *memoryClient) Get(_ context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.lookup(key)
+	if e == nil {
+		return "", exceptions.NewNotFoundError(fmt.Sprintf("%s does not exist", key))
+	}
+	v, ok := e.value.(string)
+	if !ok {
+		return "", xerrors.Errorf("failed to memory Get: wrong type. key: %s", key)
+	}
+	return v, nil
+}
+
+func (c *memoryClient) Del(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+	return nil
+}
+
+func (c *memoryClient) SAdd(_ context.Context, key string, values ...interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.lookup(key)
+	if e == nil {
+		e = c.put(key, set{}, 0)
+	}
+	s, ok := e.value.(set)
+	if !ok {
+		return xerrors.Errorf("failed to memory SAdd: wrong type. key: %s", key)
+	}
+	for _, v := range values {
+		s[toString(v)] = struct{}{}
+	}
+	return nil
+}
+
+func (c *memoryClient) SRem(_ context.Context, key string, members ...interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.lookup(key)
+	if e == nil {
+		return nil
+	}
+	s, ok := e.value.(set)
+	if !ok {
+		return xerrors.Errorf("failed to memory SRem: wrong type. key: %s", key)
+	}
+	for _, m := range members {
+		delete(s, toString(m))
+	}
+	// redisと同様に空になったsetは削除する
+	if len(s) == 0 {
+		delete(c.entries, key)
+	}
+	return nil
+}
+
+func (c *memoryClient) SMembers(_ context.Context, key string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.lookup(key)
+	if e == nil {
+		return []string{}, nil
+	}
+	s, ok := e.value.(set)
+	if !ok {
+		return nil, xerrors.Errorf("failed to memory SMembers: wrong type. key: %s", key)
+	}
+	members := make([]string, 0, len(s))
+	for m := range s {
+		members = append(members, m)
+	}
+	return members, nil
+}
+
+func (c *memoryClient) PublishStream(_ context.Context, streamKey, previousID string, maxLen int64, boundaryKey string, messages map[string]interface{}) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var st *stream
+	if e := c.lookup(streamKey); e != nil {
+		var ok bool
+		if st, ok = e.value.(*stream); !ok {
+			return "", xerrors.Errorf("failed to memory XAdd: wrong type. key: %s", streamKey)
+		}
+	}
+
+	latestID := ""
+	if st != nil && len(st.messages) > 0 {
+		latestID = st.messages[len(st.messages)-1].id.String()
+	}
+	if latestID != previousID {
+		return "", exceptions.NewConflictError(fmt.Sprintf("stream has been updated. streamKey: %s, previousID: %s", streamKey, previousID))
+	}
+
+	if st == nil {
+		st = &stream{}
+		c.put(streamKey, st, 0)
+	}
+
+	values := make(map[string]string, len(messages))
+	for k, v := range messages {
+		values[k] = toString(v)
+	}
+	msg := streamMessage{id: st.nextID(time.Now()), values: values}
+	st.messages = append(st.messages, msg)
+	if _, ok := messages[boundaryKey]; ok {
+		st.trim(maxLen, boundaryKey)
+	}
+
+	close(c.streamUpdated)
+	c.streamUpdated = make(chan struct{})
+
+	return msg.id.String(), nil
+}
+
+func (c *memoryClient) ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (id, message string, err error) {
+	prev, err := parseStreamID(previousID)
+	if err != nil {
+		return "", "", xerrors.Errorf("failed to memory XRead. err: %w, streamKey: %s, messageID: %s", err, streamKey, previousID)
+	}
+
+	timer := time.NewTimer(subscribeDuration)
+	defer timer.Stop()
+
+	for {
+		c.mu.Lock()
+		msg, found, err := c.latestAfter(streamKey, prev)
+		updated := c.streamUpdated
+		c.mu.Unlock()
+
+		if err != nil {
+			return "", "", xerrors.Errorf("failed to memory XRead. err: %w, streamKey: %s, messageID: %s", err, streamKey, previousID)
+		}
+		if found {
+			return msg.id.String(), msg.values[messageKey], nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", "", xerrors.Errorf("failed to memory XRead. err: %w, streamKey: %s, messageID: %s", ctx.Err(), streamKey, previousID)
+		case <-timer.C:
+			return "", "", exceptions.NewNotFoundError("response nil from battle stream")
+		case <-updated:
+		}
+	}
+}
+
+func (c *memoryClient) ReadStreamLatest(_ context.Context, streamKey, messageKey string) (id, message string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	msg, found, err := c.latestAfter(streamKey, streamID{})
+	if err != nil {
+		return "", "", xerrors.Errorf("failed to memory XRevRange. err: %w, streamKey: %s", err, streamKey)
+	}
+	if !found {
+		return "", "", exceptions.NewNotFoundError(fmt.Sprintf("%s does not exist", streamKey))
+	}
+	return msg.id.String(), msg.values[messageKey], nil
+}
+
+func (c *memoryClient) RangeStream(_ context.Context, streamKey, messageKey string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.lookup(streamKey)
+	if e == nil {
+		return []string{}, nil
+	}
+	st, ok := e.value.(*stream)
+	if !ok {
+		return nil, xerrors.Errorf("failed to memory XRange: wrong type. key: %s", streamKey)
+	}
+
+	values := make([]string, 0, len(st.messages))
+	for _, msg := range st.messages {
+		if v, ok := msg.values[messageKey]; ok {
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
+func (c *memoryClient) Expire(_ context.Context, key string, duration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e := c.lookup(key); e != nil {
+		e.expireAt = time.Now().Add(duration)
+	}
+	return nil
+}
+
+func (c *memoryClient) Publish(_ context.Context, channel string, message interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	msg := toString(message)
+	for ch := range c.subscriptions[channel] {
+		select {
+		case ch <- msg:
+		default:
+			// redisと同様に受信が追いつかない購読者へのmessageは破棄する
+		}
+	}
+	return nil
+}
+
+func (c *memoryClient) Subscribe(ctx context.Context, channel string) (<-chan string, error) {
+	ch := make(chan string, subscriptionBufferSize)
+
+	c.mu.Lock()
+	if c.subscriptions[channel] == nil {
+		c.subscriptions[channel] = make(map[chan string]struct{})
+	}
+	c.subscriptions[channel][ch] = struct{}{}
+	c.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		delete(c.subscriptions[channel], ch)
+		if len(c.subscriptions[channel]) == 0 {
+			delete(c.subscriptions, channel)
+		}
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
+func (c *memoryClient) ZAdd(_ context.Context, key string, members ...gateways.ZMember) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.lookup(key)
+	if e == nil {
+		e = c.put(key, zset{}, 0)
+	}
+	z, ok := e.value.(zset)
+	if !ok {
+		return xerrors.Errorf("failed to memory ZAdd: wrong type. key: %s", key)
+	}
+	for _, m := range members {
+		z[m.Member] = m.Score
+	}
+	return nil
+}
+
+func (c *memoryClient) ZRevRangeWithScores(_ context.Context, key string, start, stop int64) ([]gateways.ZMember, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	members, err := c.zRevSorted(key)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to memory ZRevRangeWithScores: %w", err)
+	}
+
+	// redisと同様に負の位置は末尾から数え、範囲外は切り詰める
+	n := int64(len(members))
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+	if start > stop {
+		return []gateways.ZMember{}, nil
+	}
+	return members[start : stop+1], nil
+}
+
+func (c *memoryClient) ZRevRank(_ context.Context, key, member string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	members, err := c.zRevSorted(key)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to memory ZRevRank: %w", err)
+	}
+	for i, m := range members {
+		if m.Member == member {
+			return int64(i), nil
+		}
+	}
+	return 0, exceptions.NewNotFoundError(fmt.Sprintf("not exist. key: %s, member: %s", key, member))
+}
+
+// zRevSorted sorted setのmemberをredisと同じくscoreの降順、同じscoreではmemberの降順で返す. c.muを保持して呼び出すこと.
+func (c *memoryClient) zRevSorted(key string) ([]gateways.ZMember, error) {
+	e := c.lookup(key)
+	if e == nil {
+		return []gateways.ZMember{}, nil
+	}
+	z, ok := e.value.(zset)
+	if !ok {
+		return nil, xerrors.Errorf("wrong type. key: %s", key)
+	}
+
+	members := make([]gateways.ZMember, 0, len(z))
+	for m, score := range z {
+		members = append(members, gateways.ZMember{Member: m, Score: score})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score > members[j].Score
+		}
+		return members[i].Member > members[j].Member
+	})
+	return members, nil
+}
+
+// lookup 期限切れのkeyは削除してnilを返す. c.muを保持して呼び出すこと.
+func (c *memoryClient) lookup(key string) *entry {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	if e.expired(time.Now()) {
+		delete(c.entries, key)
+		return nil
+	}
+	return e
+}
+
+// put durationが0の場合は期限なし. c.muを保持して呼び出すこと.
+func (c *memoryClient) put(key string, value interface{}, duration time.Duration) *entry {
+	c.sweep()
+
+	e := &entry{value: value}
+	if duration > 0 {
+		e.expireAt = time.Now().Add(duration)
+	}
+	c.entries[key] = e
+	return e
+}
+
+// sweep 参照されないまま期限切れになったkeyを定期的に削除する. c.muを保持して呼び出すこと.
+func (c *memoryClient) sweep() {
+	now := time.Now()
+	if now.Sub(c.lastSweep) < sweepInterval {
+		return
+	}
+	c.lastSweep = now
+
+	for k, e := range c.entries {
+		if e.expired(now) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// latestAfter prevより新しいmessageのうち最新のものを返す. c.muを保持して呼び出すこと.
+func (c *memoryClient) latestAfter(streamKey string, prev streamID) (streamMessage, bool, error) {
+	e := c.lookup(streamKey)
+	if e == nil {
+		return streamMessage{}, false, nil
+	}
+	st, ok := e.value.(*stream)
+	if !ok {
+		return streamMessage{}, false, xerrors.Errorf("wrong type. key: %s", streamKey)
+	}
+	if len(st.messages) == 0 {
+		return streamMessage{}, false, nil
+	}
+
+	latest := st.messages[len(st.messages)-1]
+	if !prev.less(latest.id) {
+		return streamMessage{}, false, nil
+	}
+	return latest, true, nil
+}
+
+func (e *entry) expired(now time.Time) bool {
+	return !e.expireAt.IsZero() && !now.Before(e.expireAt)
+}
+
+// nextID redisと同様に<millisecondsTime>-<sequenceNumber>形式で単調増加するidを払い出す.
+func (s *stream) nextID(now time.Time) streamID {
+	ms := now.UnixNano() / int64(time.Millisecond)
+	if ms <= s.lastMs {
+		s.lastSeq++
+	} else {
+		s.lastMs, s.lastSeq = ms, 0
+	}
+	return streamID{ms: s.lastMs, seq: s.lastSeq}
+}
+
+// trim maxLenを超えている場合、残りがmaxLen以下となる最も古いboundaryKeyを持つmessageの直前まで削除する.
+func (s *stream) trim(maxLen int64, boundaryKey string) {
+	for i := int64(len(s.messages)) - maxLen; i > 0 && i < int64(len(s.messages)); i++ {
+		if _, ok := s.messages[i].values[boundaryKey]; ok {
+			s.messages = append([]streamMessage(nil), s.messages[i:]...)
+			return
+		}
+	}
+}
+
+func parseStreamID(id string) (streamID, error) {
+	parts := strings.SplitN(id, "-", 2)
+	ms, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return streamID{}, xerrors.Errorf("invalid stream id: %s", id)
+	}
+	if len(parts) == 1 {
+		return streamID{ms: ms}, nil
+	}
+	seq, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return streamID{}, xerrors.Errorf("invalid stream id: %s", id)
+	}
+	return streamID{ms: ms, seq: seq}, nil
+}
+
+func (id streamID) less(other streamID) bool {
+	if id.ms != other.ms {
+		return id.ms < other.ms
+	}
+	return id.seq < other.seq
+}
+
+func (id streamID) String() string {
+	return fmt.Sprintf("%d-%d", id.ms, id.seq)
+}
+
+// toString redisと同様に値を文字列として保持する.
+func toString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	default:
+		return fmt.Sprint(t)
+	}
+}
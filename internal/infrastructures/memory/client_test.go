@@ -0,0 +1,250 @@
+package memory_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	. "github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/memory"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+	"golang.org/x/xerrors"
+)
+
+func TestMemoryClient(t *testing.T) {
+	cli := NewMemoryClient()
+	ctx := context.Background()
+	if err := cli.Ping(ctx); err != nil {
+		t.Fatalf("failed to Ping: %v", err)
+	}
+
+	t.Run("SetNX, Get, Expire", func(t *testing.T) {
+		key := uuid.NewString()
+		val := uuid.NewString()
+
+		const cacheLife = 100 * time.Millisecond
+
+		if err := cli.SetNX(ctx, key, val, cacheLife); err != nil {
+			t.Fatalf("failed to SetNX: %v", err)
+		}
+		if err := cli.SetNX(ctx, key, "overwritten", cacheLife); err != nil {
+			t.Fatalf("failed to SetNX: %v", err)
+		}
+
+		getVal, err := cli.Get(ctx, key)
+		if err != nil {
+			t.Fatalf("failed to Get: %v", err)
+		}
+		if val != getVal {
+			t.Fatalf("wanted %s but got %s", val, getVal)
+		}
+
+		if err := cli.Expire(ctx, key, 2*cacheLife); err != nil {
+			t.Fatalf("failed to Expire: %v", err)
+		}
+		time.Sleep(cacheLife)
+		if _, err := cli.Get(ctx, key); err != nil {
+			t.Fatalf("failed to Get: %v", err)
+		}
+
+		time.Sleep(cacheLife)
+
+		getVal, err = cli.Get(ctx, key)
+		if !exceptions.IsNotFoundError(err) {
+			t.Fatalf("failed to Get: %v", err)
+		}
+		if getVal != "" {
+			t.Fatalf("wanted empty but got %s", getVal)
+		}
+	})
+
+	t.Run("SAdd, SRem, SMembers", func(t *testing.T) {
+		key, val1, val2, val3 := uuid.NewString(), uuid.NewString(), uuid.NewString(), uuid.NewString()
+
+		if err := cli.SAdd(ctx, key, val1, val2, val3); err != nil {
+			t.Fatalf("failed to SAdd: %v", err)
+		}
+
+		if err := cli.SRem(ctx, key, val1); err != nil {
+			t.Fatalf("failed to SRem: %v", err)
+		}
+
+		members, err := cli.SMembers(ctx, key)
+		if err != nil {
+			t.Fatalf("failed to SMembers: %v", err)
+		}
+		sort.Strings(members)
+		want := []string{val2, val3}
+		sort.Strings(want)
+
+		if diff := cmp.Diff(want, members); diff != "" {
+			t.Fatalf(diff)
+		}
+
+		if err := cli.Del(ctx, key); err != nil {
+			t.Fatalf("failed to Del: %v", err)
+		}
+
+		if r, err := cli.SMembers(ctx, key); err != nil {
+			t.Fatalf("failed to SMembers: %v", err)
+		} else if len(r) != 0 {
+			t.Fatalf("got members %v, but want %v", r, []string{})
+		}
+	})
+
+	t.Run("PublishStream, ReadStream", func(t *testing.T) {
+		key, msgKey := uuid.NewString(), uuid.NewString()
+		const maxLen = 10
+
+		id1, err := cli.PublishStream(ctx, key, "", maxLen, "", map[string]interface{}{msgKey: []byte("1")})
+		if err != nil {
+			t.Fatalf("failed to PublishStream: %v", err)
+		}
+		if _, err := cli.PublishStream(ctx, key, "", maxLen, "", map[string]interface{}{msgKey: "conflict"}); !exceptions.IsConflictError(err) {
+			t.Fatalf("wanted ConflictError but got %v", err)
+		}
+
+		// 新しいmessageが追加されるまで待機する
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			if _, err := cli.PublishStream(ctx, key, id1, maxLen, "", map[string]interface{}{msgKey: "2"}); err != nil {
+				t.Errorf("failed to PublishStream: %v", err)
+			}
+		}()
+
+		id2, msg, err := cli.ReadStream(ctx, key, msgKey, id1)
+		if err != nil {
+			t.Fatalf("failed to ReadStream: %v", err)
+		}
+		if msg != "2" {
+			t.Fatalf("wanted %s but got %s", "2", msg)
+		}
+
+		id, msg, err := cli.ReadStreamLatest(ctx, key, msgKey)
+		if err != nil {
+			t.Fatalf("failed to ReadStreamLatest: %v", err)
+		}
+		if id != id2 || msg != "2" {
+			t.Fatalf("wanted (%s, %s) but got (%s, %s)", id2, "2", id, msg)
+		}
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		if _, _, err := cli.ReadStream(cancelCtx, key, msgKey, id2); !xerrors.Is(err, context.Canceled) {
+			t.Fatalf("wanted context.Canceled but got %v", err)
+		}
+
+		msgs, err := cli.RangeStream(ctx, key, msgKey)
+		if err != nil {
+			t.Fatalf("failed to RangeStream: %v", err)
+		}
+		if diff := cmp.Diff([]string{"1", "2"}, msgs); diff != "" {
+			t.Fatalf(diff)
+		}
+	})
+
+	t.Run("PublishStream, MaxLen", func(t *testing.T) {
+		key, msgKey := uuid.NewString(), uuid.NewString()
+
+		boundaryKey := uuid.NewString()
+
+		// 上限を超えても境界となるmessageを追加するまでは削除されない
+		id := ""
+		publish := func(v string, boundary bool) []string {
+			t.Helper()
+
+			messages := map[string]interface{}{msgKey: v}
+			if boundary {
+				messages[boundaryKey] = "1"
+			}
+			var err error
+			if id, err = cli.PublishStream(ctx, key, id, 3, boundaryKey, messages); err != nil {
+				t.Fatalf("failed to PublishStream: %v", err)
+			}
+			msgs, err := cli.RangeStream(ctx, key, msgKey)
+			if err != nil {
+				t.Fatalf("failed to RangeStream: %v", err)
+			}
+			return msgs
+		}
+		publish("1", true)
+		publish("2", false)
+		publish("3", true)
+		if diff := cmp.Diff([]string{"1", "2", "3", "4"}, publish("4", false)); diff != "" {
+			t.Fatalf(diff)
+		}
+
+		// 上限以下となる最も古い境界の直前まで削除される
+		if diff := cmp.Diff([]string{"3", "4", "5"}, publish("5", true)); diff != "" {
+			t.Fatalf(diff)
+		}
+	})
+
+	t.Run("Publish, Subscribe", func(t *testing.T) {
+		channel, msg := uuid.NewString(), uuid.NewString()
+
+		subCtx, cancel := context.WithCancel(ctx)
+		msgs, err := cli.Subscribe(subCtx, channel)
+		if err != nil {
+			t.Fatalf("failed to Subscribe: %v", err)
+		}
+
+		if err := cli.Publish(ctx, channel, msg); err != nil {
+			t.Fatalf("failed to Publish: %v", err)
+		}
+		if got := <-msgs; got != msg {
+			t.Fatalf("wanted %s but got %s", msg, got)
+		}
+
+		cancel()
+		if _, ok := <-msgs; ok {
+			t.Fatalf("wanted closed channel")
+		}
+	})
+
+	t.Run("ZAdd, ZRevRangeWithScores, ZRevRank", func(t *testing.T) {
+		key := uuid.NewString()
+
+		if err := cli.ZAdd(ctx, key,
+			gateways.ZMember{Member: "a", Score: 1500},
+			gateways.ZMember{Member: "b", Score: 1600},
+			gateways.ZMember{Member: "c", Score: 1500},
+		); err != nil {
+			t.Fatalf("failed to ZAdd: %v", err)
+		}
+		if err := cli.ZAdd(ctx, key, gateways.ZMember{Member: "b", Score: 1400}); err != nil {
+			t.Fatalf("failed to ZAdd: %v", err)
+		}
+
+		// 同じscoreはmemberの降順
+		want := []gateways.ZMember{{Member: "c", Score: 1500}, {Member: "a", Score: 1500}, {Member: "b", Score: 1400}}
+		got, err := cli.ZRevRangeWithScores(ctx, key, 0, -1)
+		if err != nil {
+			t.Fatalf("failed to ZRevRangeWithScores: %v", err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf(diff)
+		}
+		got, err = cli.ZRevRangeWithScores(ctx, key, 1, 5)
+		if err != nil {
+			t.Fatalf("failed to ZRevRangeWithScores: %v", err)
+		}
+		if diff := cmp.Diff(want[1:], got); diff != "" {
+			t.Fatalf(diff)
+		}
+
+		rank, err := cli.ZRevRank(ctx, key, "a")
+		if err != nil {
+			t.Fatalf("failed to ZRevRank: %v", err)
+		}
+		if rank != 1 {
+			t.Fatalf("wanted 1 but got %d", rank)
+		}
+		if _, err := cli.ZRevRank(ctx, key, "d"); !exceptions.IsNotFoundError(err) {
+			t.Fatalf("wanted NotFoundError but got %v", err)
+		}
+	})
+}
@@ -18,8 +18,14 @@ const (
 )
 
 type (
+	// client redis.Cmdableにpub/subを加えたもの. *redis.Client, *redis.ClusterClientが満たす.
+	client interface {
+		redis.Cmdable
+		Subscribe(ctx context.Context, channels ...string) *redis.PubSub
+	}
+
 	redisClient struct {
-		cli redis.Cmdable
+		cli client
 	}
 )
 
@@ -106,21 +112,58 @@ func (c *redisClient) SMembers(ctx context.Context, key string) ([]string, error
 	return members, nil
 }
 
-func (c *redisClient) PublishStream(ctx context.Context, streamKey string, messages map[string]interface{}) error {
-	values := make([]interface{}, 0, len(messages)*2)
+// publishStreamScript streamの最新のmessage idがARGV[1]と一致する場合のみXADDする.
+//   ARGV[1]が空文字の場合はstreamが存在しないことを条件とする. 一致しない場合はnilを返す.
+//   ARGV[3]が空文字でなくstreamがARGV[2]件を超えた場合、ARGV[3]のfieldを持つmessageの直前まで古いmessageを削除する.
+var publishStreamScript = redis.NewScript(`
+local latest = redis.call('XREVRANGE', KEYS[1], '+', '-', 'COUNT', 1)
+local latestID = ''
+if #latest > 0 then
+	latestID = latest[1][1]
+end
+if latestID ~= ARGV[1] then
+	return false
+end
+local id = redis.call('XADD', KEYS[1], '*', unpack(ARGV, 4))
+if ARGV[3] == '' then
+	return id
+end
+local excess = redis.call('XLEN', KEYS[1]) - tonumber(ARGV[2])
+if excess <= 0 then
+	return id
+end
+local msgs = redis.call('XRANGE', KEYS[1], '-', '+')
+for i = excess + 1, #msgs do
+	local fields = msgs[i][2]
+	for j = 1, #fields, 2 do
+		if fields[j] == ARGV[3] then
+			redis.call('XTRIM', KEYS[1], 'MAXLEN', #msgs - i + 1)
+			return id
+		end
+	end
+end
+return id
+`)
+
+func (c *redisClient) PublishStream(ctx context.Context, streamKey, previousID string, maxLen int64, boundaryKey string, messages map[string]interface{}) (string, error) {
+	// 境界となるmessageの追加時のみ削除する
+	if _, ok := messages[boundaryKey]; !ok {
+		boundaryKey = ""
+	}
+	args := make([]interface{}, 0, len(messages)*2+3)
+	args = append(args, previousID, maxLen, boundaryKey)
 	for k, v := range messages {
-		values = append(values, k, v)
+		args = append(args, k, v)
 	}
 
-	if err := c.cli.XAdd(ctx, &redis.XAddArgs{
-		Stream: streamKey,
-		MaxLen: 1,
-		ID:     "*",
-		Values: values,
-	}).Err(); err != nil {
-		return xerrors.Errorf("failed to redis XAdd: %w", err)
+	id, err := publishStreamScript.Run(ctx, c.cli, []string{streamKey}, args...).Text()
+	if err == redis.Nil {
+		return "", exceptions.NewConflictError(fmt.Sprintf("stream has been updated. streamKey: %s, previousID: %s", streamKey, previousID))
 	}
-	return nil
+	if err != nil {
+		return "", xerrors.Errorf("failed to redis XAdd: %w", err)
+	}
+	return id, nil
 }
 
 func (c *redisClient) ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (id, message string, err error) {
@@ -150,7 +193,37 @@ func (c *redisClient) ReadStream(ctx context.Context, streamKey, messageKey, pre
 }
 
 func (c *redisClient) ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (id, message string, err error) {
-	return c.ReadStream(ctx, streamKey, messageKey, "0")
+	msgs, err := c.cli.XRevRangeN(ctx, streamKey, "+", "-", 1).Result()
+	if err != nil {
+		return "", "", xerrors.Errorf("failed to redis XRevRange. err: %w, streamKey: %s", err, streamKey)
+	}
+	if len(msgs) == 0 {
+		return "", "", exceptions.NewNotFoundError(fmt.Sprintf("%s does not exist", streamKey))
+	}
+
+	msg := msgs[0]
+	v, ok := msg.Values[messageKey].(string)
+	if !ok {
+		loggers.Logger(ctx).Warn("cast to string from stream message failed", zap.Reflect("message", msg))
+		return "", "", nil
+	}
+
+	return msg.ID, v, nil
+}
+
+func (c *redisClient) RangeStream(ctx context.Context, streamKey, messageKey string) ([]string, error) {
+	msgs, err := c.cli.XRange(ctx, streamKey, "-", "+").Result()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to redis XRange. err: %w, streamKey: %s", err, streamKey)
+	}
+
+	values := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		if v, ok := msg.Values[messageKey].(string); ok {
+			values = append(values, v)
+		}
+	}
+	return values, nil
 }
 
 func (c *redisClient) Expire(ctx context.Context, key string, duration time.Duration) error {
@@ -160,3 +233,83 @@ func (c *redisClient) Expire(ctx context.Context, key string, duration time.Dura
 
 	return nil
 }
+
+func (c *redisClient) Publish(ctx context.Context, channel string, message interface{}) error {
+	if err := c.cli.Publish(ctx, channel, message).Err(); err != nil {
+		return xerrors.Errorf("failed to redis Publish: %w", err)
+	}
+	return nil
+}
+
+func (c *redisClient) Subscribe(ctx context.Context, channel string) (<-chan string, error) {
+	ps := c.cli.Subscribe(ctx, channel)
+	// 購読の開始を待ってから返す
+	if _, err := ps.Receive(ctx); err != nil {
+		_ = ps.Close()
+		return nil, xerrors.Errorf("failed to redis Subscribe: %w", err)
+	}
+
+	ch := make(chan string)
+	go func() {
+		defer close(ch)
+		defer ps.Close()
+
+		msgs := ps.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+				select {
+				case ch <- msg.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func (c *redisClient) ZAdd(ctx context.Context, key string, members ...gateways.ZMember) error {
+	zs := make([]*redis.Z, 0, len(members))
+	for _, m := range members {
+		zs = append(zs, &redis.Z{Score: m.Score, Member: m.Member})
+	}
+	if err := c.cli.ZAdd(ctx, key, zs...).Err(); err != nil {
+		return xerrors.Errorf("failed to redis ZAdd: %w", err)
+	}
+	return nil
+}
+
+func (c *redisClient) ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) ([]gateways.ZMember, error) {
+	zs, err := c.cli.ZRevRangeWithScores(ctx, key, start, stop).Result()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to redis ZRevRangeWithScores: %w", err)
+	}
+
+	members := make([]gateways.ZMember, 0, len(zs))
+	for _, z := range zs {
+		member, ok := z.Member.(string)
+		if !ok {
+			return nil, xerrors.Errorf("failed to redis ZRevRangeWithScores: unexpected member %v", z.Member)
+		}
+		members = append(members, gateways.ZMember{Member: member, Score: z.Score})
+	}
+	return members, nil
+}
+
+func (c *redisClient) ZRevRank(ctx context.Context, key, member string) (int64, error) {
+	rank, err := c.cli.ZRevRank(ctx, key, member).Result()
+	if err == redis.Nil {
+		return 0, exceptions.NewNotFoundError(fmt.Sprintf("not exist. key: %s, member: %s", key, member))
+	}
+	if err != nil {
+		return 0, xerrors.Errorf("failed to redis ZRevRank: %w", err)
+	}
+	return rank, nil
+}
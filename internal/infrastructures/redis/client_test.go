@@ -11,6 +11,7 @@ import (
 	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/env"
 	. "github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/redis"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
 )
 
 func TestRedisClient(t *testing.T) {
@@ -86,4 +87,149 @@ func TestRedisClient(t *testing.T) {
 			t.Fatalf("got members %v, but want %v", r, []string{})
 		}
 	})
+
+	t.Run("PublishStream, ReadStreamLatest", func(t *testing.T) {
+		key, msgKey := uuid.NewString(), uuid.NewString()
+		const maxLen = 10
+		defer cli.Del(ctx, key)
+
+		id1, err := cli.PublishStream(ctx, key, "", maxLen, "", map[string]interface{}{msgKey: "1"})
+		if err != nil {
+			t.Fatalf("failed to PublishStream: %v", err)
+		}
+
+		if _, err := cli.PublishStream(ctx, key, "", maxLen, "", map[string]interface{}{msgKey: "conflict"}); !exceptions.IsConflictError(err) {
+			t.Fatalf("wanted ConflictError but got %v", err)
+		}
+
+		id2, err := cli.PublishStream(ctx, key, id1, maxLen, "", map[string]interface{}{msgKey: "2"})
+		if err != nil {
+			t.Fatalf("failed to PublishStream: %v", err)
+		}
+
+		if _, err := cli.PublishStream(ctx, key, id1, maxLen, "", map[string]interface{}{msgKey: "conflict"}); !exceptions.IsConflictError(err) {
+			t.Fatalf("wanted ConflictError but got %v", err)
+		}
+
+		id, msg, err := cli.ReadStreamLatest(ctx, key, msgKey)
+		if err != nil {
+			t.Fatalf("failed to ReadStreamLatest: %v", err)
+		}
+		if id != id2 || msg != "2" {
+			t.Fatalf("wanted (%s, %s) but got (%s, %s)", id2, "2", id, msg)
+		}
+
+		msgs, err := cli.RangeStream(ctx, key, msgKey)
+		if err != nil {
+			t.Fatalf("failed to RangeStream: %v", err)
+		}
+		if diff := cmp.Diff([]string{"1", "2"}, msgs); diff != "" {
+			t.Fatalf(diff)
+		}
+	})
+
+	t.Run("PublishStream, MaxLen", func(t *testing.T) {
+		key, msgKey := uuid.NewString(), uuid.NewString()
+		defer cli.Del(ctx, key)
+
+		boundaryKey := uuid.NewString()
+
+		// 上限を超えても境界となるmessageを追加するまでは削除されない
+		id := ""
+		publish := func(v string, boundary bool) []string {
+			t.Helper()
+
+			messages := map[string]interface{}{msgKey: v}
+			if boundary {
+				messages[boundaryKey] = "1"
+			}
+			var err error
+			if id, err = cli.PublishStream(ctx, key, id, 3, boundaryKey, messages); err != nil {
+				t.Fatalf("failed to PublishStream: %v", err)
+			}
+			msgs, err := cli.RangeStream(ctx, key, msgKey)
+			if err != nil {
+				t.Fatalf("failed to RangeStream: %v", err)
+			}
+			return msgs
+		}
+		publish("1", true)
+		publish("2", false)
+		publish("3", true)
+		if diff := cmp.Diff([]string{"1", "2", "3", "4"}, publish("4", false)); diff != "" {
+			t.Fatalf(diff)
+		}
+
+		// 上限以下となる最も古い境界の直前まで削除される
+		if diff := cmp.Diff([]string{"3", "4", "5"}, publish("5", true)); diff != "" {
+			t.Fatalf(diff)
+		}
+	})
+
+	t.Run("Publish, Subscribe", func(t *testing.T) {
+		channel, msg := uuid.NewString(), uuid.NewString()
+
+		subCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+		defer cancel()
+
+		msgs, err := cli.Subscribe(subCtx, channel)
+		if err != nil {
+			t.Fatalf("failed to Subscribe: %v", err)
+		}
+
+		if err := cli.Publish(ctx, channel, msg); err != nil {
+			t.Fatalf("failed to Publish: %v", err)
+		}
+
+		if got := <-msgs; got != msg {
+			t.Fatalf("wanted %s but got %s", msg, got)
+		}
+
+		cancel()
+		for range msgs {
+		}
+	})
+
+	t.Run("ZAdd, ZRevRangeWithScores, ZRevRank", func(t *testing.T) {
+		key := uuid.NewString()
+
+		if err := cli.ZAdd(ctx, key,
+			gateways.ZMember{Member: "a", Score: 1500},
+			gateways.ZMember{Member: "b", Score: 1600},
+			gateways.ZMember{Member: "c", Score: 1500},
+		); err != nil {
+			t.Fatalf("failed to ZAdd: %v", err)
+		}
+		if err := cli.ZAdd(ctx, key, gateways.ZMember{Member: "b", Score: 1400}); err != nil {
+			t.Fatalf("failed to ZAdd: %v", err)
+		}
+
+		// 同じscoreはmemberの降順
+		want := []gateways.ZMember{{Member: "c", Score: 1500}, {Member: "a", Score: 1500}, {Member: "b", Score: 1400}}
+		got, err := cli.ZRevRangeWithScores(ctx, key, 0, -1)
+		if err != nil {
+			t.Fatalf("failed to ZRevRangeWithScores: %v", err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf(diff)
+		}
+		got, err = cli.ZRevRangeWithScores(ctx, key, 1, 5)
+		if err != nil {
+			t.Fatalf("failed to ZRevRangeWithScores: %v", err)
+		}
+		if diff := cmp.Diff(want[1:], got); diff != "" {
+			t.Fatalf(diff)
+		}
+
+		rank, err := cli.ZRevRank(ctx, key, "a")
+		if err != nil {
+			t.Fatalf("failed to ZRevRank: %v", err)
+		}
+		if rank != 1 {
+			t.Fatalf("wanted 1 but got %d", rank)
+		}
+		if _, err := cli.ZRevRank(ctx, key, "d"); !exceptions.IsNotFoundError(err) {
+			t.Fatalf("wanted NotFoundError but got %v", err)
+		}
+	})
 }
@@ -0,0 +1,97 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+	"golang.org/x/xerrors"
+	_ "modernc.org/sqlite"
+)
+
+//go:embed schema.sql
+var schema string
+
+type (
+	sqliteClient struct {
+		db *sql.DB
+		// conn queryを実行する接続. transactionの中では*sql.Tx.
+		conn conn
+		inTx bool
+	}
+
+	// conn *sql.DBと*sql.Txに共通する操作.
+	conn interface {
+		ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+		QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	}
+)
+
+// NewSQLiteClient configのdatabaseを開き、schema.sqlのtableが無ければ作成する.
+func NewSQLiteClient(config Config) (gateways.SQLClient, error) {
+	db, err := sql.Open("sqlite", config.Path)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to sql Open: %w", err)
+	}
+	// SQLiteは書き込みが直列化されるため、接続を1つに限定してlockの競合を避ける.
+	//   ":memory:"のdatabaseも接続ごとに別のものとならない.
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, xerrors.Errorf("failed to apply schema: %w", err)
+	}
+
+	return &sqliteClient{db: db, conn: db}, nil
+}
+
+func (c *sqliteClient) Ping(ctx context.Context) error {
+	if err := c.db.PingContext(ctx); err != nil {
+		return xerrors.Errorf("failed to sqlite Ping: %w", err)
+	}
+	return nil
+}
+
+func (c *sqliteClient) Exec(ctx context.Context, query string, args ...interface{}) (int64, error) {
+	res, err := c.conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to sqlite Exec: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, xerrors.Errorf("failed to RowsAffected: %w", err)
+	}
+	return n, nil
+}
+
+func (c *sqliteClient) Query(ctx context.Context, query string, args ...interface{}) (gateways.SQLRows, error) {
+	rows, err := c.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to sqlite Query: %w", err)
+	}
+	return rows, nil
+}
+
+func (c *sqliteClient) Transaction(ctx context.Context, fn func(tx gateways.SQLClient) error) error {
+	if c.inTx {
+		return fn(c)
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return xerrors.Errorf("failed to sqlite BeginTx: %w", err)
+	}
+	if err := fn(&sqliteClient{db: c.db, conn: tx, inTx: true}); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return xerrors.Errorf("failed to sqlite Rollback: %v, cause: %w", rbErr, err)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return xerrors.Errorf("failed to sqlite Commit: %w", err)
+	}
+
+	return nil
+}
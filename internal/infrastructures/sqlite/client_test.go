@@ -0,0 +1,113 @@
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+	"golang.org/x/xerrors"
+
+	. "github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/sqlite"
+)
+
+func TestSQLiteClient(t *testing.T) {
+	cli, err := NewSQLiteClient(Config{Path: ":memory:"})
+	if err != nil {
+		t.Fatalf("failed to NewSQLiteClient: %v", err)
+	}
+	ctx := context.Background()
+	if err := cli.Ping(ctx); err != nil {
+		t.Fatalf("failed to Ping: %v", err)
+	}
+
+	finishedAt := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	const insert = `INSERT OR REPLACE INTO games VALUES (?, '1', 'player_a', 'player_b', '{}', '{}', 0, 'draw', 'agreement', '[]', ?, '[]', ?, ?, NULL, NULL)`
+	for _, moveCount := range []int{3, 5} {
+		n, err := cli.Exec(ctx, insert, "1-game", moveCount, finishedAt, finishedAt)
+		if err != nil {
+			t.Fatalf("failed to Exec: %v", err)
+		}
+		if n != 1 {
+			t.Fatalf("wanted 1 row affected but got %d", n)
+		}
+	}
+
+	rows, err := cli.Query(ctx, `SELECT move_count, finished_at FROM games WHERE player_a_id = ?`, "player_a")
+	if err != nil {
+		t.Fatalf("failed to Query: %v", err)
+	}
+	defer rows.Close()
+
+	var count int
+	for rows.Next() {
+		var (
+			moveCount int
+			got       time.Time
+		)
+		if err := rows.Scan(&moveCount, &got); err != nil {
+			t.Fatalf("failed to Scan: %v", err)
+		}
+		if moveCount != 5 || !got.Equal(finishedAt) {
+			t.Fatalf("unexpected row: %d, %s", moveCount, got)
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("failed to read rows: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("wanted 1 row but got %d", count)
+	}
+}
+
+func TestSQLiteClient_Transaction(t *testing.T) {
+	cli, err := NewSQLiteClient(Config{Path: ":memory:"})
+	if err != nil {
+		t.Fatalf("failed to NewSQLiteClient: %v", err)
+	}
+	ctx := context.Background()
+	updatedAt := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	const insert = `INSERT INTO ratings VALUES (?, 1500, 350, 0.06, 0, ?)`
+
+	tests := []struct {
+		name    string
+		fnErr   error
+		wantErr bool
+		want    int
+	}{
+		{name: "commit", want: 2},
+		{name: "rollback", fnErr: xerrors.New("abort"), wantErr: true, want: 2},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cli.Transaction(ctx, func(tx gateways.SQLClient) error {
+				for _, id := range []string{"a", "b"} {
+					if _, err := tx.Exec(ctx, insert, fmt.Sprintf("%s-%d", id, i), updatedAt); err != nil {
+						return err
+					}
+				}
+				return tt.fnErr
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			rows, err := cli.Query(ctx, `SELECT COUNT(*) FROM ratings`)
+			if err != nil {
+				t.Fatalf("failed to Query: %v", err)
+			}
+			defer rows.Close()
+			var got int
+			for rows.Next() {
+				if err := rows.Scan(&got); err != nil {
+					t.Fatalf("failed to Scan: %v", err)
+				}
+			}
+			if got != tt.want {
+				t.Fatalf("wanted %d rows but got %d", tt.want, got)
+			}
+		})
+	}
+}
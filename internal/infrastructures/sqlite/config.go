@@ -0,0 +1,8 @@
+package sqlite
+
+// Config is
+// SQLite settings.
+type Config struct {
+	// Path databaseファイルのパス. ":memory:"の場合はプロセスの終了時に消える.
+	Path string `envconfig:"path" default:"archive.db"`
+}
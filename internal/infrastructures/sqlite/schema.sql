@@ -0,0 +1,53 @@
+CREATE TABLE IF NOT EXISTS games (
+    game_id          TEXT PRIMARY KEY,
+    room_id          TEXT     NOT NULL,
+    player_a_id      TEXT     NOT NULL,
+    player_b_id      TEXT     NOT NULL,
+    rule_set         TEXT     NOT NULL,
+    time_control     TEXT     NOT NULL,
+    ranked           INTEGER  NOT NULL,
+    result           TEXT     NOT NULL,
+    reason           TEXT     NOT NULL,
+    win_lines        TEXT     NOT NULL,
+    move_count       INTEGER  NOT NULL,
+    moves            TEXT     NOT NULL,
+    started_at       DATETIME NOT NULL,
+    finished_at      DATETIME NOT NULL,
+    player_a_opening INTEGER,
+    player_b_opening INTEGER
+);
+
+CREATE INDEX IF NOT EXISTS games_player_a_id ON games (player_a_id, finished_at);
+CREATE INDEX IF NOT EXISTS games_player_b_id ON games (player_b_id, finished_at);
+CREATE INDEX IF NOT EXISTS games_ranked ON games (ranked, finished_at);
+
+CREATE TABLE IF NOT EXISTS ratings (
+    player_id  TEXT PRIMARY KEY,
+    rating     REAL     NOT NULL,
+    rd         REAL     NOT NULL,
+    volatility REAL     NOT NULL,
+    games      INTEGER  NOT NULL,
+    updated_at DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS game_ratings (
+    game_id         TEXT PRIMARY KEY,
+    player_a_before REAL     NOT NULL,
+    player_a_after  REAL     NOT NULL,
+    player_b_before REAL     NOT NULL,
+    player_b_after  REAL     NOT NULL,
+    rated_at        DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS seasons (
+    number    INTEGER PRIMARY KEY,
+    closed_at DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS season_standings (
+    season    INTEGER NOT NULL,
+    rank      INTEGER NOT NULL,
+    player_id TEXT    NOT NULL,
+    rating    REAL    NOT NULL,
+    PRIMARY KEY (season, rank)
+);
@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/interactors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	loginContextKey struct{}
+)
+
+// authFreeMethods 認証前に呼び出されるため、セッションの検証を行わないmethod.
+var authFreeMethods = map[string]struct{}{
+	"/tictactoe_battle.TicTacToeBattleService/Login":        {},
+	"/tictactoe_battle.TicTacToeBattleService/Logout":       {},
+	"/tictactoe_battle.TicTacToeBattleService/CanEnterRoom": {},
+}
+
+// adminMethods login id, session idの代わりにadmin tokenで認証するmethod.
+var adminMethods = map[string]struct{}{
+	"/tictactoe_battle_ext.TicTacToeBattleExtService/CloseSeason": {},
+}
+
+// AuthFuncOverride implements grpc_auth.ServiceAuthFuncOverride.
+//   metadataのlogin id, session idを検証し、認証済みのloginをcontextに格納する.
+func (c *ticTacToeBattleController) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
+	if _, ok := authFreeMethods[fullMethodName]; ok {
+		return ctx, nil
+	}
+	return authenticate(ctx, c.loginInteractor)
+}
+
+// AuthFuncOverride implements grpc_auth.ServiceAuthFuncOverride.
+//   管理者用のmethodはmetadataのadmin tokenを、それ以外の全てのmethodはlogin id, session idを検証する.
+func (c *ticTacToeBattleExtController) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
+	if _, ok := adminMethods[fullMethodName]; ok {
+		return authenticateAdmin(ctx, c.adminToken)
+	}
+	return authenticate(ctx, c.loginInteractor)
+}
+
+// authenticateAdmin metadataのadmin tokenがadminTokenと一致するか検証する. adminTokenが空の場合は常に拒否する.
+func authenticateAdmin(ctx context.Context, adminToken string) (context.Context, error) {
+	if adminToken == "" {
+		return nil, status.Error(codes.PermissionDenied, "admin methods are disabled")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(adminTokenFromMetadata(ctx)), []byte(adminToken)) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "admin token does not match")
+	}
+
+	return ctx, nil
+}
+
+// authenticate metadataのlogin id, session idを検証し、認証済みのloginをcontextに格納する.
+func authenticate(ctx context.Context, li interactors.LoginInteractor) (context.Context, error) {
+	login := loginFromMetadata(ctx)
+	if err := li.Authenticate(ctx, login); err != nil {
+		if exceptions.IsUnauthenticatedError(err) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		loggers.Logger(ctx).Error("failed to Authenticate", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to authenticate")
+	}
+
+	ctx = loggers.With(ctx, loggers.Map{"login_id": login.LoginId})
+	return context.WithValue(ctx, loginContextKey{}, login), nil
+}
+
+// loginFromContext AuthFuncOverrideで認証済みのloginを返す.
+func loginFromContext(ctx context.Context) *tictactoe_battle.Login {
+	login, ok := ctx.Value(loginContextKey{}).(*tictactoe_battle.Login)
+	if !ok {
+		return &tictactoe_battle.Login{}
+	}
+	return login
+}
+
+// authorizedLoginID requestで指定されたlogin idが認証済みのものと一致するか検証する.
+//   未指定の場合は認証済みのlogin idを使用する.
+func authorizedLoginID(ctx context.Context, requested string) (string, error) {
+	loginID := loginFromContext(ctx).LoginId
+	if requested != "" && requested != loginID {
+		return "", exceptions.NewPermissionDeniedError("login id does not match the authenticated login")
+	}
+	return loginID, nil
+}
@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	mock_interactors "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/interactors"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTicTacToeBattleController_AuthFuncOverride(t *testing.T) {
+	const battleMethod = "/tictactoe_battle.TicTacToeBattleService/Attack"
+	login := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+
+	tests := []struct {
+		name     string
+		method   string
+		authErr  error
+		wantAuth bool
+		wantCode codes.Code
+	}{
+		{name: "login", method: "/tictactoe_battle.TicTacToeBattleService/Login", wantCode: codes.OK},
+		{name: "logout", method: "/tictactoe_battle.TicTacToeBattleService/Logout", wantCode: codes.OK},
+		{name: "can enter room", method: "/tictactoe_battle.TicTacToeBattleService/CanEnterRoom", wantCode: codes.OK},
+		{name: "authenticated", method: battleMethod, wantAuth: true, wantCode: codes.OK},
+		{name: "missing or incorrect session", method: battleMethod, wantAuth: true,
+			authErr: exceptions.NewUnauthenticatedError("session id does not match"), wantCode: codes.Unauthenticated},
+		{name: "failed to authenticate", method: battleMethod, wantAuth: true,
+			authErr: xerrors.New("unexpected"), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// 認証不要のmethodではAuthenticateを呼び出さない
+			loginInteractor := mock_interactors.NewMockLoginInteractor(ctrl)
+			if tt.wantAuth {
+				loginInteractor.EXPECT().Authenticate(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, got *tictactoe_battle.Login) error {
+						if got.LoginId != login.LoginId || got.SessionId != login.SessionId {
+							t.Fatalf("wanted %v but got %v", login, got)
+						}
+						return tt.authErr
+					})
+			}
+			c := &ticTacToeBattleController{loginInteractor: loginInteractor}
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				loginIDMetadataKey, login.LoginId,
+				sessionIDMetadataKey, login.SessionId,
+			))
+			got, err := c.AuthFuncOverride(ctx, tt.method)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("wanted %s but got %s", tt.wantCode, code)
+			}
+			if tt.wantAuth && err == nil && loginFromContext(got).LoginId != login.LoginId {
+				t.Fatalf("login is not stored in the context")
+			}
+		})
+	}
+}
+
+func TestTicTacToeBattleExtController_AuthFuncOverride(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// TicTacToeBattleServiceで認証不要のmethodと同じ名前でも認証する
+	loginInteractor := mock_interactors.NewMockLoginInteractor(ctrl)
+	loginInteractor.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Return(exceptions.NewUnauthenticatedError("not logged in"))
+	c := &ticTacToeBattleExtController{loginInteractor: loginInteractor}
+
+	_, err := c.AuthFuncOverride(context.Background(), "/tictactoe_battle_ext.TicTacToeBattleExtService/Login")
+	if code := status.Code(err); code != codes.Unauthenticated {
+		t.Fatalf("wanted %s but got %s", codes.Unauthenticated, code)
+	}
+}
+
+func TestTicTacToeBattleExtController_AuthFuncOverride_Admin(t *testing.T) {
+	const closeSeason = "/tictactoe_battle_ext.TicTacToeBattleExtService/CloseSeason"
+
+	tests := []struct {
+		name       string
+		adminToken string
+		token      string
+		wantCode   codes.Code
+	}{
+		{name: "authenticated", adminToken: "secret", token: "secret", wantCode: codes.OK},
+		{name: "incorrect token", adminToken: "secret", token: "guess", wantCode: codes.Unauthenticated},
+		{name: "missing token", adminToken: "secret", wantCode: codes.Unauthenticated},
+		{name: "disabled", token: "secret", wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// 管理者用のmethodではsessionを検証しない
+			loginInteractor := mock_interactors.NewMockLoginInteractor(ctrl)
+			c := &ticTacToeBattleExtController{adminToken: tt.adminToken, loginInteractor: loginInteractor}
+
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(adminTokenMetadataKey, tt.token))
+			}
+			_, err := c.AuthFuncOverride(ctx, closeSeason)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("wanted %s but got %s", tt.wantCode, code)
+			}
+		})
+	}
+}
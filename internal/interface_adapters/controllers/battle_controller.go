@@ -2,10 +2,8 @@ package controllers
 
 import (
 	"context"
-	"time"
 
 	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
-	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/listener"
@@ -14,7 +12,12 @@ import (
 )
 
 func (c *ticTacToeBattleController) CreateRoom(ctx context.Context, _ *tictactoe_battle.CreateRoomRequest) (*tictactoe_battle.CreateRoomResponse, error) {
-	roomID, err := c.battleInteractor.Create(ctx)
+	opts, err := createOptionsFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roomID, err := c.battleInteractor.Create(ctx, opts...)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to Create: %w", err)
 	}
@@ -35,48 +38,49 @@ func (c *ticTacToeBattleController) CanEnterRoom(ctx context.Context, req *ticta
 
 func (c *ticTacToeBattleController) EnterRoom(request *tictactoe_battle.EnterRoomRequest, stream tictactoe_battle.TicTacToeBattleService_EnterRoomServer) error {
 	ctx := loggers.LoggerToContext(stream.Context(), c.logger)
-	lsnr, err := c.battleInteractor.Enter(ctx, room.ID(request.RoomId), request.LoginId)
+	loginID, err := authorizedLoginID(ctx, request.LoginId)
+	if err != nil {
+		return err
+	}
+
+	lsnr, err := c.battleInteractor.Enter(ctx, room.ID(request.RoomId), loginID)
 	if err != nil {
 		return xerrors.Errorf("failed to Enter: %w", err)
 	}
 
 	for {
-		select {
-		case <-ctx.Done():
-			return nil
-		default:
-			bt, err := lsnr.Listen(ctx)
-			if err != nil {
-				if exceptions.IsNotFoundError(err) {
-					time.Sleep(time.Second)
-					continue
-				}
-				if xerrors.As(err, &listener.LeftError) {
-					loggers.Logger(ctx).Info("already left the room")
-					return nil
-				}
-				if xerrors.As(err, &context.Canceled) {
-					loggers.Logger(ctx).Info("context canceled")
-					return nil
-				}
-
-				loggers.Logger(ctx).Error("failed to Listen", zap.Error(err))
-				return xerrors.Errorf("failed to Listen: %w", err)
+		bt, err := lsnr.Listen(ctx)
+		if err != nil {
+			if xerrors.Is(err, listener.LeftError) {
+				loggers.Logger(ctx).Info("already left the room")
+				return nil
+			}
+			if xerrors.Is(err, context.Canceled) {
+				loggers.Logger(ctx).Info("context canceled")
+				return nil
 			}
-			if err := stream.Send(bt); err != nil {
-				if xerrors.As(err, &context.Canceled) {
-					loggers.Logger(ctx).Debug("client context canceled")
-					return nil
-				}
 
-				return xerrors.Errorf("failed to Send: %w", err)
+			loggers.Logger(ctx).Error("failed to Listen", zap.Error(err))
+			return xerrors.Errorf("failed to Listen: %w", err)
+		}
+		if err := stream.Send(bt.Situation); err != nil {
+			if xerrors.Is(err, context.Canceled) {
+				loggers.Logger(ctx).Debug("client context canceled")
+				return nil
 			}
+
+			return xerrors.Errorf("failed to Send: %w", err)
 		}
 	}
 }
 
 func (c *ticTacToeBattleController) Declaration(ctx context.Context, req *tictactoe_battle.DeclarationRequest) (*tictactoe_battle.NoBody, error) {
-	if err := c.battleInteractor.Declaration(ctx, room.ID(req.RoomId), req.LoginId); err != nil {
+	loginID, err := authorizedLoginID(ctx, req.LoginId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.battleInteractor.Declaration(ctx, room.ID(req.RoomId), loginID); err != nil {
 		return nil, xerrors.Errorf("failed to Declaration: %w", err)
 	}
 
@@ -84,7 +88,12 @@ func (c *ticTacToeBattleController) Declaration(ctx context.Context, req *tictac
 }
 
 func (c *ticTacToeBattleController) LeaveRoom(ctx context.Context, req *tictactoe_battle.LeaveRoomRequest) (*tictactoe_battle.NoBody, error) {
-	if err := c.battleInteractor.Leave(ctx, room.ID(req.RoomId), req.LoginId); err != nil {
+	loginID, err := authorizedLoginID(ctx, req.LoginId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.battleInteractor.Leave(ctx, room.ID(req.RoomId), loginID); err != nil {
 		return nil, xerrors.Errorf("failed to Leave: %w", err)
 	}
 
@@ -92,7 +101,7 @@ func (c *ticTacToeBattleController) LeaveRoom(ctx context.Context, req *tictacto
 }
 
 func (c *ticTacToeBattleController) Attack(ctx context.Context, req *tictactoe_battle.AttackRequest) (*tictactoe_battle.NoBody, error) {
-	if err := c.battleInteractor.Attack(ctx, room.ID(req.RoomId), req.Player, req.Position, req.Piece); err != nil {
+	if err := c.battleInteractor.Attack(ctx, room.ID(req.RoomId), loginFromContext(ctx), req.Position, req.Piece); err != nil {
 		return nil, xerrors.Errorf("failed to Attack: %w", err)
 	}
 
@@ -100,7 +109,7 @@ func (c *ticTacToeBattleController) Attack(ctx context.Context, req *tictactoe_b
 }
 
 func (c *ticTacToeBattleController) Pick(ctx context.Context, req *tictactoe_battle.PickRequest) (*tictactoe_battle.NoBody, error) {
-	if err := c.battleInteractor.Pick(ctx, room.ID(req.RoomId), req.Player, req.Position, req.Piece); err != nil {
+	if err := c.battleInteractor.Pick(ctx, room.ID(req.RoomId), loginFromContext(ctx), req.Position, req.Piece); err != nil {
 		return nil, xerrors.Errorf("failed to Pick: %w", err)
 	}
 
@@ -108,7 +117,7 @@ func (c *ticTacToeBattleController) Pick(ctx context.Context, req *tictactoe_bat
 }
 
 func (c *ticTacToeBattleController) ResetBattle(ctx context.Context, req *tictactoe_battle.ResetBattleRequest) (*tictactoe_battle.NoBody, error) {
-	if err := c.battleInteractor.Reset(ctx, room.ID(req.RoomId)); err != nil {
+	if err := c.battleInteractor.Reset(ctx, room.ID(req.RoomId), loginFromContext(ctx)); err != nil {
 		return nil, xerrors.Errorf("failed to Reset: %w", err)
 	}
 
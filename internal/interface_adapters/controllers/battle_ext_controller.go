@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/bot"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/listener"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+)
+
+// EnterRoom TicTacToeBattleServiceのEnterRoomと同様にroomに入室し、tictactoe-battle-protoに定義されていない情報を含めて配信する.
+func (c *ticTacToeBattleExtController) EnterRoom(req *tictactoe_battle_ext.EnterRoomRequest, stream tictactoe_battle_ext.TicTacToeBattleExtService_EnterRoomServer) error {
+	ctx := loggers.LoggerToContext(stream.Context(), c.logger)
+	lsnr, err := c.battleInteractor.Enter(ctx, room.ID(req.RoomId), loginFromContext(ctx).LoginId)
+	if err != nil {
+		return xerrors.Errorf("failed to Enter: %w", err)
+	}
+
+	for {
+		bs, err := lsnr.Listen(ctx)
+		if err != nil {
+			if xerrors.Is(err, listener.LeftError) {
+				loggers.Logger(ctx).Info("already left the room")
+				return nil
+			}
+			if xerrors.Is(err, context.Canceled) {
+				loggers.Logger(ctx).Info("context canceled")
+				return nil
+			}
+
+			loggers.Logger(ctx).Error("failed to Listen", zap.Error(err))
+			return xerrors.Errorf("failed to Listen: %w", err)
+		}
+		if err := stream.Send(bs); err != nil {
+			if xerrors.Is(err, context.Canceled) {
+				loggers.Logger(ctx).Debug("client context canceled")
+				return nil
+			}
+
+			return xerrors.Errorf("failed to Send: %w", err)
+		}
+	}
+}
+
+func (c *ticTacToeBattleExtController) LegalMoves(ctx context.Context, req *tictactoe_battle_ext.LegalMovesRequest) (*tictactoe_battle_ext.LegalMovesResponse, error) {
+	actions, err := c.battleInteractor.LegalMoves(ctx, room.ID(req.RoomId), loginFromContext(ctx))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to LegalMoves: %w", err)
+	}
+
+	res := &tictactoe_battle_ext.LegalMovesResponse{Actions: make([]*tictactoe_battle_ext.Action, 0, len(actions))}
+	for _, a := range actions {
+		res.Actions = append(res.Actions, toAction(a))
+	}
+	return res, nil
+}
+
+func (c *ticTacToeBattleExtController) SuggestMove(ctx context.Context, req *tictactoe_battle_ext.SuggestMoveRequest) (*tictactoe_battle_ext.SuggestMoveResponse, error) {
+	analysis, err := c.battleInteractor.SuggestMove(ctx, room.ID(req.RoomId), loginFromContext(ctx))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to SuggestMove: %w", err)
+	}
+
+	return &tictactoe_battle_ext.SuggestMoveResponse{
+		Action:  toAction(analysis.Action),
+		Outcome: toOutcome(analysis.Outcome),
+		Moves:   int32(analysis.Moves),
+	}, nil
+}
+
+func (c *ticTacToeBattleExtController) Resign(ctx context.Context, req *tictactoe_battle_ext.ResignRequest) (*tictactoe_battle.NoBody, error) {
+	if err := c.battleInteractor.Resign(ctx, room.ID(req.RoomId), loginFromContext(ctx)); err != nil {
+		return nil, xerrors.Errorf("failed to Resign: %w", err)
+	}
+
+	return &tictactoe_battle.NoBody{}, nil
+}
+
+func (c *ticTacToeBattleExtController) OfferDraw(ctx context.Context, req *tictactoe_battle_ext.OfferDrawRequest) (*tictactoe_battle.NoBody, error) {
+	if err := c.battleInteractor.OfferDraw(ctx, room.ID(req.RoomId), loginFromContext(ctx)); err != nil {
+		return nil, xerrors.Errorf("failed to OfferDraw: %w", err)
+	}
+
+	return &tictactoe_battle.NoBody{}, nil
+}
+
+func (c *ticTacToeBattleExtController) AcceptDraw(ctx context.Context, req *tictactoe_battle_ext.AcceptDrawRequest) (*tictactoe_battle.NoBody, error) {
+	if err := c.battleInteractor.AcceptDraw(ctx, room.ID(req.RoomId), loginFromContext(ctx)); err != nil {
+		return nil, xerrors.Errorf("failed to AcceptDraw: %w", err)
+	}
+
+	return &tictactoe_battle.NoBody{}, nil
+}
+
+func (c *ticTacToeBattleExtController) DeclineDraw(ctx context.Context, req *tictactoe_battle_ext.DeclineDrawRequest) (*tictactoe_battle.NoBody, error) {
+	if err := c.battleInteractor.DeclineDraw(ctx, room.ID(req.RoomId), loginFromContext(ctx)); err != nil {
+		return nil, xerrors.Errorf("failed to DeclineDraw: %w", err)
+	}
+
+	return &tictactoe_battle.NoBody{}, nil
+}
+
+func (c *ticTacToeBattleExtController) RequestRematch(ctx context.Context, req *tictactoe_battle_ext.RequestRematchRequest) (*tictactoe_battle.NoBody, error) {
+	if err := c.battleInteractor.RequestRematch(ctx, room.ID(req.RoomId), loginFromContext(ctx)); err != nil {
+		return nil, xerrors.Errorf("failed to RequestRematch: %w", err)
+	}
+
+	return &tictactoe_battle.NoBody{}, nil
+}
+
+func (c *ticTacToeBattleExtController) AcceptRematch(ctx context.Context, req *tictactoe_battle_ext.AcceptRematchRequest) (*tictactoe_battle.NoBody, error) {
+	if err := c.battleInteractor.AcceptRematch(ctx, room.ID(req.RoomId), loginFromContext(ctx)); err != nil {
+		return nil, xerrors.Errorf("failed to AcceptRematch: %w", err)
+	}
+
+	return &tictactoe_battle.NoBody{}, nil
+}
+
+func toAction(a battle.Action) *tictactoe_battle_ext.Action {
+	return &tictactoe_battle_ext.Action{From: a.From, To: a.To, Piece: a.Piece}
+}
+
+func toOutcome(o bot.Outcome) tictactoe_battle_ext.Outcome {
+	switch o {
+	case bot.OutcomeUndecided:
+		return tictactoe_battle_ext.Outcome_OUTCOME_UNDECIDED
+	case bot.OutcomeWin:
+		return tictactoe_battle_ext.Outcome_OUTCOME_WIN
+	case bot.OutcomeDraw:
+		return tictactoe_battle_ext.Outcome_OUTCOME_DRAW
+	case bot.OutcomeLoss:
+		return tictactoe_battle_ext.Outcome_OUTCOME_LOSS
+	default:
+		return tictactoe_battle_ext.Outcome_OUTCOME_UNDEFINED
+	}
+}
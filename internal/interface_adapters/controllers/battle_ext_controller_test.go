@@ -0,0 +1,279 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/bot"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	mock_interactors "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/interactors"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/listener"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// fakeRoomListener situationsを順に返し、全て返した後は退室したものとする.
+	fakeRoomListener struct {
+		situations []*tictactoe_battle_ext.BattleSituation
+	}
+
+	// fakeRoomStream 送信されたBattleSituationを記録する.
+	fakeRoomStream struct {
+		grpc.ServerStream
+		ctx  context.Context
+		sent []*tictactoe_battle_ext.BattleSituation
+	}
+)
+
+func (l *fakeRoomListener) Listen(_ context.Context) (*tictactoe_battle_ext.BattleSituation, error) {
+	if len(l.situations) == 0 {
+		return nil, listener.LeftError
+	}
+	bs := l.situations[0]
+	l.situations = l.situations[1:]
+	return bs, nil
+}
+
+func (s *fakeRoomStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeRoomStream) Send(bs *tictactoe_battle_ext.BattleSituation) error {
+	s.sent = append(s.sent, bs)
+	return nil
+}
+
+func TestTicTacToeBattleExtController_EnterRoom(t *testing.T) {
+	const roomID = room.ID("room-1")
+	login := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	situations := []*tictactoe_battle_ext.BattleSituation{
+		{Situation: &tictactoe_battle.BattleSituation{RoomId: roomID.String()}},
+		{
+			Situation: &tictactoe_battle.BattleSituation{RoomId: roomID.String()},
+			Clock:     &tictactoe_battle_ext.Clock{PlayerARemainingMs: 1000, PlayerBRemainingMs: 2000},
+		},
+	}
+	battleInteractor := mock_interactors.NewMockBattleInteractor(ctrl)
+	battleInteractor.EXPECT().Enter(gomock.Any(), roomID, login.LoginId).
+		Return(&fakeRoomListener{situations: append([]*tictactoe_battle_ext.BattleSituation{}, situations...)}, nil)
+
+	c := &ticTacToeBattleExtController{logger: zap.NewNop(), battleInteractor: battleInteractor}
+	stream := &fakeRoomStream{ctx: context.WithValue(context.Background(), loginContextKey{}, login)}
+	if err := c.EnterRoom(&tictactoe_battle_ext.EnterRoomRequest{RoomId: roomID.String()}, stream); err != nil {
+		t.Fatalf("failed to EnterRoom: %v", err)
+	}
+	if len(stream.sent) != len(situations) {
+		t.Fatalf("wanted %d situations but got %d", len(situations), len(stream.sent))
+	}
+	for i, bs := range situations {
+		if !proto.Equal(stream.sent[i], bs) {
+			t.Fatalf("wanted %v but got %v", bs, stream.sent[i])
+		}
+	}
+}
+
+func TestTicTacToeBattleExtController_LegalMoves(t *testing.T) {
+	const roomID = room.ID("room-1")
+	login := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	actions := []battle.Action{
+		{From: tictactoe_battle.Position_POSITION_UNDEFINED, To: tictactoe_battle.Position_POSITION_X1Y1, Piece: tictactoe_battle.Piece_PIECE_L},
+		{From: tictactoe_battle.Position_POSITION_X0Y0, To: tictactoe_battle.Position_POSITION_X2Y2, Piece: tictactoe_battle.Piece_PIECE_S},
+	}
+	battleInteractor := mock_interactors.NewMockBattleInteractor(ctrl)
+	battleInteractor.EXPECT().LegalMoves(gomock.Any(), roomID, login).Return(actions, nil)
+
+	c := &ticTacToeBattleExtController{battleInteractor: battleInteractor}
+	ctx := context.WithValue(context.Background(), loginContextKey{}, login)
+	res, err := c.LegalMoves(ctx, &tictactoe_battle_ext.LegalMovesRequest{RoomId: roomID.String()})
+	if err != nil {
+		t.Fatalf("failed to LegalMoves: %v", err)
+	}
+	if len(res.Actions) != len(actions) {
+		t.Fatalf("wanted %d actions but got %d", len(actions), len(res.Actions))
+	}
+	for i, a := range actions {
+		got := res.Actions[i]
+		if got.From != a.From || got.To != a.To || got.Piece != a.Piece {
+			t.Fatalf("wanted %v but got %v", a, got)
+		}
+	}
+}
+
+func TestTicTacToeBattleExtController_SuggestMove(t *testing.T) {
+	const roomID = room.ID("room-1")
+	login := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+	action := battle.Action{
+		From:  tictactoe_battle.Position_POSITION_UNDEFINED,
+		To:    tictactoe_battle.Position_POSITION_X1Y1,
+		Piece: tictactoe_battle.Piece_PIECE_L,
+	}
+
+	tests := []struct {
+		name        string
+		analysis    *bot.Analysis
+		wantOutcome tictactoe_battle_ext.Outcome
+		wantMoves   int32
+	}{
+		{name: "undecided", analysis: &bot.Analysis{Action: action, Outcome: bot.OutcomeUndecided},
+			wantOutcome: tictactoe_battle_ext.Outcome_OUTCOME_UNDECIDED},
+		{name: "win", analysis: &bot.Analysis{Action: action, Outcome: bot.OutcomeWin, Moves: 2},
+			wantOutcome: tictactoe_battle_ext.Outcome_OUTCOME_WIN, wantMoves: 2},
+		{name: "draw", analysis: &bot.Analysis{Action: action, Outcome: bot.OutcomeDraw},
+			wantOutcome: tictactoe_battle_ext.Outcome_OUTCOME_DRAW},
+		{name: "loss", analysis: &bot.Analysis{Action: action, Outcome: bot.OutcomeLoss, Moves: 3},
+			wantOutcome: tictactoe_battle_ext.Outcome_OUTCOME_LOSS, wantMoves: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			battleInteractor := mock_interactors.NewMockBattleInteractor(ctrl)
+			battleInteractor.EXPECT().SuggestMove(gomock.Any(), roomID, login).Return(tt.analysis, nil)
+
+			c := &ticTacToeBattleExtController{battleInteractor: battleInteractor}
+			ctx := context.WithValue(context.Background(), loginContextKey{}, login)
+			res, err := c.SuggestMove(ctx, &tictactoe_battle_ext.SuggestMoveRequest{RoomId: roomID.String()})
+			if err != nil {
+				t.Fatalf("failed to SuggestMove: %v", err)
+			}
+			if res.Action.To != action.To || res.Action.Piece != action.Piece {
+				t.Fatalf("wanted %v but got %v", action, res.Action)
+			}
+			if res.Outcome != tt.wantOutcome || res.Moves != tt.wantMoves {
+				t.Fatalf("wanted (%s, %d) but got (%s, %d)", tt.wantOutcome, tt.wantMoves, res.Outcome, res.Moves)
+			}
+		})
+	}
+}
+
+func TestTicTacToeBattleExtController_ResignAndDraw(t *testing.T) {
+	const roomID = room.ID("room-1")
+	login := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+
+	tests := []struct {
+		name   string
+		expect func(m *mock_interactors.MockBattleInteractor) *gomock.Call
+		call   func(c *ticTacToeBattleExtController, ctx context.Context) error
+	}{
+		{
+			name: "resign",
+			expect: func(m *mock_interactors.MockBattleInteractor) *gomock.Call {
+				return m.EXPECT().Resign(gomock.Any(), roomID, login)
+			},
+			call: func(c *ticTacToeBattleExtController, ctx context.Context) error {
+				_, err := c.Resign(ctx, &tictactoe_battle_ext.ResignRequest{RoomId: roomID.String()})
+				return err
+			},
+		},
+		{
+			name: "offer draw",
+			expect: func(m *mock_interactors.MockBattleInteractor) *gomock.Call {
+				return m.EXPECT().OfferDraw(gomock.Any(), roomID, login)
+			},
+			call: func(c *ticTacToeBattleExtController, ctx context.Context) error {
+				_, err := c.OfferDraw(ctx, &tictactoe_battle_ext.OfferDrawRequest{RoomId: roomID.String()})
+				return err
+			},
+		},
+		{
+			name: "accept draw",
+			expect: func(m *mock_interactors.MockBattleInteractor) *gomock.Call {
+				return m.EXPECT().AcceptDraw(gomock.Any(), roomID, login)
+			},
+			call: func(c *ticTacToeBattleExtController, ctx context.Context) error {
+				_, err := c.AcceptDraw(ctx, &tictactoe_battle_ext.AcceptDrawRequest{RoomId: roomID.String()})
+				return err
+			},
+		},
+		{
+			name: "decline draw",
+			expect: func(m *mock_interactors.MockBattleInteractor) *gomock.Call {
+				return m.EXPECT().DeclineDraw(gomock.Any(), roomID, login)
+			},
+			call: func(c *ticTacToeBattleExtController, ctx context.Context) error {
+				_, err := c.DeclineDraw(ctx, &tictactoe_battle_ext.DeclineDrawRequest{RoomId: roomID.String()})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			battleInteractor := mock_interactors.NewMockBattleInteractor(ctrl)
+			tt.expect(battleInteractor).Return(nil)
+
+			c := &ticTacToeBattleExtController{battleInteractor: battleInteractor}
+			ctx := context.WithValue(context.Background(), loginContextKey{}, login)
+			if err := tt.call(c, ctx); err != nil {
+				t.Fatalf("failed to %s: %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestTicTacToeBattleExtController_Rematch(t *testing.T) {
+	const roomID = room.ID("room-1")
+	login := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+
+	tests := []struct {
+		name   string
+		expect func(m *mock_interactors.MockBattleInteractor) *gomock.Call
+		call   func(c *ticTacToeBattleExtController, ctx context.Context) error
+	}{
+		{
+			name: "request rematch",
+			expect: func(m *mock_interactors.MockBattleInteractor) *gomock.Call {
+				return m.EXPECT().RequestRematch(gomock.Any(), roomID, login)
+			},
+			call: func(c *ticTacToeBattleExtController, ctx context.Context) error {
+				_, err := c.RequestRematch(ctx, &tictactoe_battle_ext.RequestRematchRequest{RoomId: roomID.String()})
+				return err
+			},
+		},
+		{
+			name: "accept rematch",
+			expect: func(m *mock_interactors.MockBattleInteractor) *gomock.Call {
+				return m.EXPECT().AcceptRematch(gomock.Any(), roomID, login)
+			},
+			call: func(c *ticTacToeBattleExtController, ctx context.Context) error {
+				_, err := c.AcceptRematch(ctx, &tictactoe_battle_ext.AcceptRematchRequest{RoomId: roomID.String()})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			battleInteractor := mock_interactors.NewMockBattleInteractor(ctrl)
+			tt.expect(battleInteractor).Return(nil)
+
+			c := &ticTacToeBattleExtController{battleInteractor: battleInteractor}
+			ctx := context.WithValue(context.Background(), loginContextKey{}, login)
+			if err := tt.call(c, ctx); err != nil {
+				t.Fatalf("failed to %s: %v", tt.name, err)
+			}
+		})
+	}
+}
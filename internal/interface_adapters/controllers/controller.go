@@ -3,10 +3,17 @@ package controllers
 import (
 	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/interactors"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 type (
+	Interceptor interface {
+		UnaryServerInterceptor() grpc.UnaryServerInterceptor
+		StreamServerInterceptor() grpc.StreamServerInterceptor
+	}
+
 	ticTacToeBattleController struct {
 		logger *zap.Logger
 		tictactoe_battle.UnimplementedTicTacToeBattleServiceServer
@@ -14,6 +21,21 @@ type (
 		loginInteractor  interactors.LoginInteractor
 		battleInteractor interactors.BattleInteractor
 	}
+
+	// ticTacToeBattleExtController is
+	//   tictactoe-battle-protoに定義されていないRPCのcontroller.
+	ticTacToeBattleExtController struct {
+		logger *zap.Logger
+		tictactoe_battle_ext.UnimplementedTicTacToeBattleExtServiceServer
+		// adminToken 管理者用のmethodの認証に使うtoken. 空の場合は管理者用のmethodを呼び出せない.
+		adminToken string
+
+		loginInteractor       interactors.LoginInteractor
+		battleInteractor      interactors.BattleInteractor
+		playerInteractor      interactors.PlayerInteractor
+		replayInteractor      interactors.ReplayInteractor
+		leaderboardInteractor interactors.LeaderboardInteractor
+	}
 )
 
 func NewTicTacToeBattleController(logger *zap.Logger, iFactory interactors.Factory) tictactoe_battle.TicTacToeBattleServiceServer {
@@ -23,3 +45,15 @@ func NewTicTacToeBattleController(logger *zap.Logger, iFactory interactors.Facto
 		battleInteractor: iFactory.BattleInteractor(),
 	}
 }
+
+func NewTicTacToeBattleExtController(logger *zap.Logger, iFactory interactors.Factory, adminToken string) tictactoe_battle_ext.TicTacToeBattleExtServiceServer {
+	return &ticTacToeBattleExtController{
+		logger:                logger,
+		adminToken:            adminToken,
+		loginInteractor:       iFactory.LoginInteractor(),
+		battleInteractor:      iFactory.BattleInteractor(),
+		playerInteractor:      iFactory.PlayerInteractor(),
+		replayInteractor:      iFactory.ReplayInteractor(),
+		leaderboardInteractor: iFactory.LeaderboardInteractor(),
+	}
+}
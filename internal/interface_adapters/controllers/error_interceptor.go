@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain ErrorInfo.Domainに設定する値.
+const errorDomain = "tictactoe_battle"
+
+// 例外の種別ごとのreason. clientが判定に使用するため値は変更しないこと.
+const (
+	reasonNotFound           = "NOT_FOUND"
+	reasonPreconditionFailed = "PRECONDITION_FAILED"
+	reasonInvalidArgument    = "INVALID_ARGUMENT"
+	reasonPermissionDenied   = "PERMISSION_DENIED"
+	reasonUnauthenticated    = "UNAUTHENTICATED"
+	reasonConflict           = "CONFLICT"
+	reasonResourceExhausted  = "RESOURCE_EXHAUSTED"
+	reasonCanceled           = "CANCELED"
+	reasonInternal           = "INTERNAL"
+)
+
+type (
+	errorInterceptor struct{}
+)
+
+// preconditionViolations 盤面ではなく対局の状態によるルール違反. 状態が変われば同じ操作が成功するためFailedPreconditionとする.
+var preconditionViolations = map[battle.Violation]struct{}{
+	battle.ViolationNotYourTurn:      {},
+	battle.ViolationAlreadyPicked:    {},
+	battle.ViolationTimeExpired:      {},
+	battle.ViolationTimeRemaining:    {},
+	battle.ViolationNotInProgress:    {},
+	battle.ViolationNoDrawOffer:      {},
+	battle.ViolationNotFinished:      {},
+	battle.ViolationNoRematchRequest: {},
+}
+
+func NewErrorInterceptor() Interceptor {
+	return &errorInterceptor{}
+}
+
+func (i *errorInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatusError(ctx, err)
+		}
+		return resp, nil
+	}
+}
+
+func (i *errorInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, stream); err != nil {
+			return toStatusError(stream.Context(), err)
+		}
+		return nil
+	}
+}
+
+// toStatusError exceptionsの型をgRPCのstatusに変換する.
+//   clientが判定できるようにErrorInfoのreasonを付与する.
+func toStatusError(ctx context.Context, err error) error {
+	var se interface {
+		GRPCStatus() *status.Status
+	}
+	if xerrors.As(err, &se) {
+		return se.GRPCStatus().Err()
+	}
+
+	var (
+		code   codes.Code
+		reason string
+		msg    = err.Error()
+	)
+	if v, ok := battle.AsViolationError(err); ok {
+		code, reason = codes.InvalidArgument, string(v.Violation)
+		if _, ok := preconditionViolations[v.Violation]; ok {
+			code = codes.FailedPrecondition
+		}
+	} else {
+		switch {
+		case exceptions.IsNotFoundError(err):
+			code, reason = codes.NotFound, reasonNotFound
+		case exceptions.IsSessionMismatchError(err):
+			code, reason = codes.FailedPrecondition, reasonPreconditionFailed
+		case exceptions.IsInvalidArgumentError(err):
+			code, reason = codes.InvalidArgument, reasonInvalidArgument
+		case exceptions.IsPermissionDeniedError(err):
+			code, reason = codes.PermissionDenied, reasonPermissionDenied
+		case exceptions.IsUnauthenticatedError(err):
+			code, reason = codes.Unauthenticated, reasonUnauthenticated
+		case exceptions.IsConflictError(err):
+			code, reason = codes.Aborted, reasonConflict
+		case exceptions.IsResourceExhaustedError(err):
+			code, reason = codes.ResourceExhausted, reasonResourceExhausted
+		case xerrors.Is(err, context.Canceled):
+			code, reason = codes.Canceled, reasonCanceled
+		default:
+			// 内部の詳細はclientに返さずログにのみ出力する
+			loggers.Logger(ctx).Error("internal server error", zap.Error(err))
+			code, reason, msg = codes.Internal, reasonInternal, "internal server error"
+		}
+	}
+
+	st := status.New(code, msg)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+	}); err == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
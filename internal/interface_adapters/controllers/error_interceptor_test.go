@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/mode"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+	"golang.org/x/xerrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusError(t *testing.T) {
+	rule := battle.NewRule()
+	b := rule.OpenBattle(battle.DefaultRuleSet())
+	violation := rule.Attack(b, tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_S)
+	b.State = management_state.PlayerATurn
+	invalid := rule.Attack(b, tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_UNDEFINED, tictactoe_battle.Piece_PIECE_S)
+
+	// apply movesを順に適用し、最初のエラーを返す.
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	apply := func(moves ...*battle.Move) error {
+		b := rule.OpenBattle(battle.DefaultRuleSet())
+		opening := []*battle.Move{
+			battle.NewOpen(battle.DefaultRuleSet(), battle.TimeControl{Type: battle.TimeControlPerMove, Base: 10 * time.Second}, false),
+			battle.NewDeclaration("player_a"),
+			battle.NewDeclaration("player_b"),
+		}
+		for _, m := range append(opening, moves...) {
+			if m.PlayedAt.IsZero() {
+				m.PlayedAt = start
+			}
+			if err := battle.Apply(rule, b, m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	at := func(m *battle.Move, d time.Duration) *battle.Move {
+		m.PlayedAt = start.Add(d)
+		return m
+	}
+	alreadyPicked := apply(
+		battle.NewAttack(tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_S),
+		battle.NewAttack(tictactoe_battle.Player_PLAYER_B, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_S),
+		battle.NewPick(tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_S),
+		battle.NewPick(tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_S),
+	)
+	timeExpired := apply(at(battle.NewAttack(tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_S), 11*time.Second))
+	timeRemaining := apply(at(battle.NewTimeout(tictactoe_battle.Player_PLAYER_A), time.Second))
+	notInProgress := apply(battle.NewResign(tictactoe_battle.Player_PLAYER_A), battle.NewResign(tictactoe_battle.Player_PLAYER_B))
+	noDrawOffer := apply(battle.NewAcceptDraw(tictactoe_battle.Player_PLAYER_B))
+	notFinished := apply(battle.NewRematchRequest(tictactoe_battle.Player_PLAYER_A))
+	finished := rule.OpenBattle(battle.DefaultRuleSet())
+	for _, id := range []string{"player_a", "player_b"} {
+		if err := rule.Declaration(finished, id); err != nil {
+			t.Fatalf("failed to Declaration: %v", err)
+		}
+	}
+	if err := rule.Resign(finished, tictactoe_battle.Player_PLAYER_B); err != nil {
+		t.Fatalf("failed to Resign: %v", err)
+	}
+	_, noRematchRequest := rule.AcceptRematch(finished, tictactoe_battle.Player_PLAYER_A)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantReason string
+	}{
+		{"not found", exceptions.NewNotFoundError("not found"), codes.NotFound, reasonNotFound},
+		{"precondition", exceptions.NewPreConditionError("mismatch"), codes.FailedPrecondition, reasonPreconditionFailed},
+		{"invalid argument", exceptions.NewInvalidArgumentError("invalid"), codes.InvalidArgument, reasonInvalidArgument},
+		{"permission denied", exceptions.NewPermissionDeniedError("denied"), codes.PermissionDenied, reasonPermissionDenied},
+		{"conflict", exceptions.NewConflictError("conflict"), codes.Aborted, reasonConflict},
+		{"resource exhausted", exceptions.NewResourceExhaustedError("too many"), codes.ResourceExhausted, reasonResourceExhausted},
+		{"rule violation", xerrors.Errorf("failed to Attack: %w", violation), codes.FailedPrecondition, string(battle.ViolationNotYourTurn)},
+		{"already picked", alreadyPicked, codes.FailedPrecondition, string(battle.ViolationAlreadyPicked)},
+		{"time expired", timeExpired, codes.FailedPrecondition, string(battle.ViolationTimeExpired)},
+		{"time remaining", timeRemaining, codes.FailedPrecondition, string(battle.ViolationTimeRemaining)},
+		{"not in progress", notInProgress, codes.FailedPrecondition, string(battle.ViolationNotInProgress)},
+		{"no draw offer", noDrawOffer, codes.FailedPrecondition, string(battle.ViolationNoDrawOffer)},
+		{"not finished", notFinished, codes.FailedPrecondition, string(battle.ViolationNotFinished)},
+		{"no rematch request", noRematchRequest, codes.FailedPrecondition, string(battle.ViolationNoRematchRequest)},
+		{"invalid move", xerrors.Errorf("failed to Attack: %w", invalid), codes.InvalidArgument, string(battle.ViolationInvalidPosition)},
+		{"internal", xerrors.New("unexpected"), codes.Internal, reasonInternal},
+	}
+
+	ctx := loggers.LoggerToContext(context.Background(), loggers.NewZapLogger(mode.Test))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(toStatusError(ctx, xerrors.Errorf("wrapped: %w", tt.err)))
+			if !ok {
+				t.Fatalf("not a status error")
+			}
+			if st.Code() != tt.wantCode {
+				t.Fatalf("wanted %s but got %s", tt.wantCode, st.Code())
+			}
+
+			details := st.Details()
+			if len(details) != 1 {
+				t.Fatalf("wanted 1 detail but got %d", len(details))
+			}
+			info, ok := details[0].(*errdetails.ErrorInfo)
+			if !ok {
+				t.Fatalf("unexpected detail: %v", details[0])
+			}
+			if info.Reason != tt.wantReason {
+				t.Fatalf("wanted %s but got %s", tt.wantReason, info.Reason)
+			}
+		})
+	}
+}
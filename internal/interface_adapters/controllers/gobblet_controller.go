@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"golang.org/x/xerrors"
+)
+
+func (c *ticTacToeBattleExtController) PlayGobblet(ctx context.Context, req *tictactoe_battle_ext.PlayGobbletRequest) (*tictactoe_battle.NoBody, error) {
+	a := battle.GobbletAction{Stack: int(req.Action.GetStack()), From: int(req.Action.GetFrom()), To: int(req.Action.GetTo())}
+	if err := c.battleInteractor.PlayGobblet(ctx, room.ID(req.RoomId), loginFromContext(ctx), a); err != nil {
+		return nil, xerrors.Errorf("failed to PlayGobblet: %w", err)
+	}
+
+	return &tictactoe_battle.NoBody{}, nil
+}
+
+func (c *ticTacToeBattleExtController) GobbletBoard(ctx context.Context, req *tictactoe_battle_ext.GobbletBoardRequest) (*tictactoe_battle_ext.GobbletBoardResponse, error) {
+	g, err := c.battleInteractor.GobbletBoard(ctx, room.ID(req.RoomId))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to GobbletBoard: %w", err)
+	}
+
+	res := &tictactoe_battle_ext.GobbletBoardResponse{
+		Cells:         make([]*tictactoe_battle_ext.GobbletCell, 0, len(g.Cells)),
+		PlayerAStacks: toInt32s(g.PlayerAStacks[:]),
+		PlayerBStacks: toInt32s(g.PlayerBStacks[:]),
+		WinLines:      make([]*tictactoe_battle_ext.GobbletLine, 0, len(g.WinLines)),
+	}
+	for _, cell := range g.Cells {
+		pieces := make([]*tictactoe_battle_ext.GobbletPiece, 0, len(cell))
+		for _, p := range cell {
+			pieces = append(pieces, &tictactoe_battle_ext.GobbletPiece{Owner: p.Owner, Size: int32(p.Size)})
+		}
+		res.Cells = append(res.Cells, &tictactoe_battle_ext.GobbletCell{Pieces: pieces})
+	}
+	for _, line := range g.WinLines {
+		res.WinLines = append(res.WinLines, &tictactoe_battle_ext.GobbletLine{Positions: toInt32s(line)})
+	}
+
+	return res, nil
+}
+
+func toInt32s(s []int) []int32 {
+	ret := make([]int32, 0, len(s))
+	for _, v := range s {
+		ret = append(ret, int32(v))
+	}
+	return ret
+}
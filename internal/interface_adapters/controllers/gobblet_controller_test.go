@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	mock_interactors "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/interactors"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+)
+
+func TestTicTacToeBattleExtController_PlayGobblet(t *testing.T) {
+	const roomID = room.ID("room-1")
+	login := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+
+	tests := []struct {
+		name   string
+		action *tictactoe_battle_ext.GobbletAction
+		want   battle.GobbletAction
+	}{
+		{name: "place", action: &tictactoe_battle_ext.GobbletAction{Stack: 2, From: battle.GobbletReserve, To: 5},
+			want: battle.NewGobbletPlace(2, 5)},
+		{name: "relocation", action: &tictactoe_battle_ext.GobbletAction{From: 5, To: 10},
+			want: battle.NewGobbletRelocation(5, 10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			battleInteractor := mock_interactors.NewMockBattleInteractor(ctrl)
+			battleInteractor.EXPECT().PlayGobblet(gomock.Any(), roomID, login, tt.want).Return(nil)
+
+			c := &ticTacToeBattleExtController{battleInteractor: battleInteractor}
+			ctx := context.WithValue(context.Background(), loginContextKey{}, login)
+			req := &tictactoe_battle_ext.PlayGobbletRequest{RoomId: roomID.String(), Action: tt.action}
+			if _, err := c.PlayGobblet(ctx, req); err != nil {
+				t.Fatalf("failed to PlayGobblet: %v", err)
+			}
+		})
+	}
+}
+
+func TestTicTacToeBattleExtController_GobbletBoard(t *testing.T) {
+	const roomID = room.ID("room-1")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	a := battle.GobbletPiece{Owner: tictactoe_battle.Player_PLAYER_A, Size: 0}
+	b := battle.GobbletPiece{Owner: tictactoe_battle.Player_PLAYER_B, Size: 3}
+	g := &battle.GobbletBoard{
+		Cells:         [][]battle.GobbletPiece{{a, b}, {}, {a}},
+		PlayerAStacks: [3]int{4, 3, 2},
+		PlayerBStacks: [3]int{3, 4, 4},
+		WinLines:      [][]int{{0, 1, 2, 3}},
+	}
+	battleInteractor := mock_interactors.NewMockBattleInteractor(ctrl)
+	battleInteractor.EXPECT().GobbletBoard(gomock.Any(), roomID).Return(g, nil)
+
+	c := &ticTacToeBattleExtController{battleInteractor: battleInteractor}
+	res, err := c.GobbletBoard(context.Background(), &tictactoe_battle_ext.GobbletBoardRequest{RoomId: roomID.String()})
+	if err != nil {
+		t.Fatalf("failed to GobbletBoard: %v", err)
+	}
+
+	if len(res.Cells) != len(g.Cells) {
+		t.Fatalf("wanted %d cells but got %d", len(g.Cells), len(res.Cells))
+	}
+	for i, cell := range g.Cells {
+		if len(res.Cells[i].Pieces) != len(cell) {
+			t.Fatalf("cell %d: wanted %d pieces but got %d", i, len(cell), len(res.Cells[i].Pieces))
+		}
+		for j, p := range cell {
+			got := res.Cells[i].Pieces[j]
+			if got.Owner != p.Owner || int(got.Size) != p.Size {
+				t.Fatalf("cell %d: wanted %v but got %v", i, p, got)
+			}
+		}
+	}
+	for i := range g.PlayerAStacks {
+		if int(res.PlayerAStacks[i]) != g.PlayerAStacks[i] || int(res.PlayerBStacks[i]) != g.PlayerBStacks[i] {
+			t.Fatalf("wanted stacks %v %v but got %v %v", g.PlayerAStacks, g.PlayerBStacks, res.PlayerAStacks, res.PlayerBStacks)
+		}
+	}
+	if len(res.WinLines) != 1 || len(res.WinLines[0].Positions) != len(g.WinLines[0]) {
+		t.Fatalf("wanted win lines %v but got %v", g.WinLines, res.WinLines)
+	}
+}
@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/leaderboard"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"golang.org/x/xerrors"
+)
+
+func (c *ticTacToeBattleExtController) GetLeaderboard(ctx context.Context, req *tictactoe_battle_ext.GetLeaderboardRequest) (*tictactoe_battle_ext.GetLeaderboardResponse, error) {
+	board, err := leaderboard.ParseBoard(req.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := c.leaderboardInteractor.GetLeaderboard(ctx, board, req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to GetLeaderboard: %w", err)
+	}
+
+	return &tictactoe_battle_ext.GetLeaderboardResponse{
+		Board:         string(page.Board),
+		Season:        int32(page.Season),
+		Entries:       toLeaderboardEntries(page.Entries),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (c *ticTacToeBattleExtController) GetMyRank(ctx context.Context, req *tictactoe_battle_ext.GetMyRankRequest) (*tictactoe_battle_ext.GetMyRankResponse, error) {
+	board, err := leaderboard.ParseBoard(req.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := c.leaderboardInteractor.GetMyRank(ctx, board, loginFromContext(ctx).LoginId)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to GetMyRank: %w", err)
+	}
+
+	return &tictactoe_battle_ext.GetMyRankResponse{Entry: toLeaderboardEntry(*e)}, nil
+}
+
+func (c *ticTacToeBattleExtController) GetSeason(ctx context.Context, req *tictactoe_battle_ext.GetSeasonRequest) (*tictactoe_battle_ext.GetSeasonResponse, error) {
+	s, err := c.leaderboardInteractor.GetSeason(ctx, int(req.Number))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to GetSeason: %w", err)
+	}
+
+	return &tictactoe_battle_ext.GetSeasonResponse{Season: toSeason(s)}, nil
+}
+
+// CloseSeason AuthFuncOverrideでadmin tokenを検証済みの場合のみ呼び出される.
+func (c *ticTacToeBattleExtController) CloseSeason(ctx context.Context, _ *tictactoe_battle_ext.CloseSeasonRequest) (*tictactoe_battle_ext.CloseSeasonResponse, error) {
+	s, err := c.leaderboardInteractor.CloseSeason(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to CloseSeason: %w", err)
+	}
+
+	return &tictactoe_battle_ext.CloseSeasonResponse{Season: toSeason(s)}, nil
+}
+
+func toSeason(s *leaderboard.Season) *tictactoe_battle_ext.Season {
+	return &tictactoe_battle_ext.Season{
+		Number:     int32(s.Number),
+		ClosedAtMs: unixMillis(s.ClosedAt),
+		Standings:  toLeaderboardEntries(s.Standings),
+	}
+}
+
+func toLeaderboardEntries(entries []leaderboard.Entry) []*tictactoe_battle_ext.LeaderboardEntry {
+	ret := make([]*tictactoe_battle_ext.LeaderboardEntry, 0, len(entries))
+	for _, e := range entries {
+		ret = append(ret, toLeaderboardEntry(e))
+	}
+	return ret
+}
+
+func toLeaderboardEntry(e leaderboard.Entry) *tictactoe_battle_ext.LeaderboardEntry {
+	return &tictactoe_battle_ext.LeaderboardEntry{Rank: int32(e.Rank), LoginId: e.PlayerID, Rating: e.Rating}
+}
@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/leaderboard"
+	mock_interactors "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/interactors"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTicTacToeBattleExtController_GetLeaderboard(t *testing.T) {
+	page := &leaderboard.Page{
+		Board:         leaderboard.BoardAllTime,
+		Season:        2,
+		Entries:       []leaderboard.Entry{{Rank: 1, PlayerID: "player_a", Rating: 1620.5}},
+		NextPageToken: "1",
+	}
+
+	tests := []struct {
+		name      string
+		board     string
+		wantBoard leaderboard.Board
+		wantErr   bool
+	}{
+		{name: "default board", board: "", wantBoard: leaderboard.BoardAllTime},
+		{name: "weekly", board: "weekly", wantBoard: leaderboard.BoardWeekly},
+		{name: "unknown board", board: "monthly", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			leaderboardInteractor := mock_interactors.NewMockLeaderboardInteractor(ctrl)
+			if !tt.wantErr {
+				leaderboardInteractor.EXPECT().GetLeaderboard(gomock.Any(), tt.wantBoard, "", 10).Return(page, nil)
+			}
+
+			c := &ticTacToeBattleExtController{leaderboardInteractor: leaderboardInteractor}
+			res, err := c.GetLeaderboard(context.Background(), &tictactoe_battle_ext.GetLeaderboardRequest{Board: tt.board, PageSize: 10})
+			if tt.wantErr {
+				if !exceptions.IsInvalidArgumentError(err) {
+					t.Fatalf("wanted InvalidArgumentError but got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to GetLeaderboard: %v", err)
+			}
+
+			want := &tictactoe_battle_ext.GetLeaderboardResponse{
+				Board:         "all_time",
+				Season:        2,
+				Entries:       []*tictactoe_battle_ext.LeaderboardEntry{{Rank: 1, LoginId: "player_a", Rating: 1620.5}},
+				NextPageToken: "1",
+			}
+			if !proto.Equal(res, want) {
+				t.Fatalf("wanted %v but got %v", want, res)
+			}
+		})
+	}
+}
+
+func TestTicTacToeBattleExtController_GetMyRank(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	login := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+	leaderboardInteractor := mock_interactors.NewMockLeaderboardInteractor(ctrl)
+	leaderboardInteractor.EXPECT().GetMyRank(gomock.Any(), leaderboard.BoardDaily, login.LoginId).
+		Return(&leaderboard.Entry{Rank: 3, PlayerID: login.LoginId, Rating: 1510}, nil)
+
+	c := &ticTacToeBattleExtController{leaderboardInteractor: leaderboardInteractor}
+	ctx := context.WithValue(context.Background(), loginContextKey{}, login)
+	res, err := c.GetMyRank(ctx, &tictactoe_battle_ext.GetMyRankRequest{Board: "daily"})
+	if err != nil {
+		t.Fatalf("failed to GetMyRank: %v", err)
+	}
+
+	want := &tictactoe_battle_ext.LeaderboardEntry{Rank: 3, LoginId: login.LoginId, Rating: 1510}
+	if !proto.Equal(res.Entry, want) {
+		t.Fatalf("wanted %v but got %v", want, res.Entry)
+	}
+}
+
+func TestTicTacToeBattleExtController_Season(t *testing.T) {
+	s := &leaderboard.Season{
+		Number:    1,
+		ClosedAt:  time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+		Standings: []leaderboard.Entry{{Rank: 1, PlayerID: "player_a", Rating: 1700}, {Rank: 2, PlayerID: "player_b", Rating: 1650}},
+	}
+	want := &tictactoe_battle_ext.Season{
+		Number:     1,
+		ClosedAtMs: 1625097600000,
+		Standings: []*tictactoe_battle_ext.LeaderboardEntry{
+			{Rank: 1, LoginId: "player_a", Rating: 1700},
+			{Rank: 2, LoginId: "player_b", Rating: 1650},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		expect func(m *mock_interactors.MockLeaderboardInteractor) *gomock.Call
+		call   func(c *ticTacToeBattleExtController) (*tictactoe_battle_ext.Season, error)
+	}{
+		{
+			name: "get season",
+			expect: func(m *mock_interactors.MockLeaderboardInteractor) *gomock.Call {
+				return m.EXPECT().GetSeason(gomock.Any(), 1)
+			},
+			call: func(c *ticTacToeBattleExtController) (*tictactoe_battle_ext.Season, error) {
+				res, err := c.GetSeason(context.Background(), &tictactoe_battle_ext.GetSeasonRequest{Number: 1})
+				return res.GetSeason(), err
+			},
+		},
+		{
+			name: "close season",
+			expect: func(m *mock_interactors.MockLeaderboardInteractor) *gomock.Call {
+				return m.EXPECT().CloseSeason(gomock.Any())
+			},
+			call: func(c *ticTacToeBattleExtController) (*tictactoe_battle_ext.Season, error) {
+				res, err := c.CloseSeason(context.Background(), &tictactoe_battle_ext.CloseSeasonRequest{})
+				return res.GetSeason(), err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			leaderboardInteractor := mock_interactors.NewMockLeaderboardInteractor(ctrl)
+			tt.expect(leaderboardInteractor).Return(s, nil)
+
+			c := &ticTacToeBattleExtController{leaderboardInteractor: leaderboardInteractor}
+			got, err := tt.call(c)
+			if err != nil {
+				t.Fatalf("failed to %s: %v", tt.name, err)
+			}
+			if !proto.Equal(got, want) {
+				t.Fatalf("wanted %v but got %v", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/bot"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/interactors"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	loginIDMetadataKey     = "login-id"
+	sessionIDMetadataKey   = "session-id"
+	adminTokenMetadataKey  = "admin-token"
+	botLevelMetadataKey    = "bot-level"
+	botSeatMetadataKey     = "bot-seat"
+	rankedMetadataKey      = "ranked"
+	ruleSetMetadataKey     = "rule-set"
+	timeControlMetadataKey = "time-control"
+)
+
+// loginFromMetadata requestのmetadataからloginを取り出す. 存在しない項目は空文字になる.
+func loginFromMetadata(ctx context.Context) *tictactoe_battle.Login {
+	login := &tictactoe_battle.Login{}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return login
+	}
+	if v := md.Get(loginIDMetadataKey); len(v) > 0 {
+		login.LoginId = v[0]
+	}
+	if v := md.Get(sessionIDMetadataKey); len(v) > 0 {
+		login.SessionId = v[0]
+	}
+
+	return login
+}
+
+// adminTokenFromMetadata requestのmetadataからadmin tokenを取り出す. 存在しない場合は空文字.
+func adminTokenFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(adminTokenMetadataKey); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// createOptionsFromMetadata requestのmetadataからroom作成時のoptionを取り出す.
+//   bot-levelが指定された場合はbotを参加させる. bot-seatは"A"または"B"で、省略時は"B".
+//   rankedが"true"の場合はレーティング対象のroomとする. rule-setはpresetの名前またはJSON.
+//   time-controlは"fischer:5m+3s"または"per_move:30s"の形式.
+func createOptionsFromMetadata(ctx context.Context) ([]interactors.CreateOption, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	var opts []interactors.CreateOption
+	if v := md.Get(rankedMetadataKey); len(v) > 0 {
+		ranked, err := strconv.ParseBool(v[0])
+		if err != nil {
+			return nil, exceptions.NewInvalidArgumentError(fmt.Sprintf("invalid ranked: %s", v[0]))
+		}
+		if ranked {
+			opts = append(opts, interactors.WithRanked())
+		}
+	}
+	if v := md.Get(ruleSetMetadataKey); len(v) > 0 {
+		rs, err := battle.ParseRuleSet(v[0])
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, interactors.WithRuleSet(rs))
+	}
+	if v := md.Get(timeControlMetadataKey); len(v) > 0 {
+		tc, err := battle.ParseTimeControl(v[0])
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, interactors.WithTimeControl(tc))
+	}
+
+	levels := md.Get(botLevelMetadataKey)
+	if len(levels) == 0 {
+		return opts, nil
+	}
+	level, err := bot.ParseLevel(levels[0])
+	if err != nil {
+		return nil, err
+	}
+
+	seat := tictactoe_battle.Player_PLAYER_B
+	if v := md.Get(botSeatMetadataKey); len(v) > 0 {
+		switch strings.ToUpper(v[0]) {
+		case "A":
+			seat = tictactoe_battle.Player_PLAYER_A
+		case "B":
+		default:
+			return nil, exceptions.NewInvalidArgumentError(fmt.Sprintf("unknown bot seat: %s", v[0]))
+		}
+	}
+
+	return append(opts, interactors.WithBot(level, seat)), nil
+}
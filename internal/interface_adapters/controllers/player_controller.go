@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"golang.org/x/xerrors"
+)
+
+func (c *ticTacToeBattleExtController) GetPlayerProfile(ctx context.Context, req *tictactoe_battle_ext.GetPlayerProfileRequest) (*tictactoe_battle_ext.GetPlayerProfileResponse, error) {
+	p, err := c.playerInteractor.GetPlayerProfile(ctx, req.LoginId)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to GetPlayerProfile: %w", err)
+	}
+
+	return &tictactoe_battle_ext.GetPlayerProfileResponse{
+		LoginId:         p.PlayerID,
+		Wins:            int32(p.Wins),
+		Losses:          int32(p.Losses),
+		Draws:           int32(p.Draws),
+		Streak:          int32(p.Streak),
+		FavoriteOpening: p.FavoriteOpening,
+	}, nil
+}
+
+func (c *ticTacToeBattleExtController) ListMatchHistory(ctx context.Context, req *tictactoe_battle_ext.ListMatchHistoryRequest) (*tictactoe_battle_ext.ListMatchHistoryResponse, error) {
+	h, err := c.playerInteractor.ListMatchHistory(ctx, req.LoginId, req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ListMatchHistory: %w", err)
+	}
+
+	res := &tictactoe_battle_ext.ListMatchHistoryResponse{
+		Matches:       make([]*tictactoe_battle_ext.Match, 0, len(h.Matches)),
+		NextPageToken: h.NextPageToken,
+	}
+	for _, m := range h.Matches {
+		res.Matches = append(res.Matches, toMatch(m))
+	}
+	return res, nil
+}
+
+func toMatch(m archive.Match) *tictactoe_battle_ext.Match {
+	return &tictactoe_battle_ext.Match{
+		GameId:       m.GameID.String(),
+		Player:       m.Player,
+		OpponentId:   m.OpponentID,
+		Outcome:      string(m.Outcome),
+		Reason:       string(m.Reason),
+		Mode:         string(m.Mode),
+		Ranked:       m.Ranked,
+		MoveCount:    int32(m.MoveCount),
+		StartedAtMs:  unixMillis(m.StartedAt),
+		FinishedAtMs: unixMillis(m.FinishedAt),
+	}
+}
+
+func unixMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
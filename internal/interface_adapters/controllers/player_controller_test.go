@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	mock_interactors "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/interactors"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTicTacToeBattleExtController_GetPlayerProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	p := &archive.Profile{
+		PlayerID:        "player_a",
+		Wins:            3,
+		Losses:          2,
+		Draws:           1,
+		Streak:          -2,
+		FavoriteOpening: tictactoe_battle.Position_POSITION_X1Y1,
+	}
+	playerInteractor := mock_interactors.NewMockPlayerInteractor(ctrl)
+	playerInteractor.EXPECT().GetPlayerProfile(gomock.Any(), p.PlayerID).Return(p, nil)
+
+	c := &ticTacToeBattleExtController{playerInteractor: playerInteractor}
+	res, err := c.GetPlayerProfile(context.Background(), &tictactoe_battle_ext.GetPlayerProfileRequest{LoginId: p.PlayerID})
+	if err != nil {
+		t.Fatalf("failed to GetPlayerProfile: %v", err)
+	}
+	want := &tictactoe_battle_ext.GetPlayerProfileResponse{
+		LoginId:         "player_a",
+		Wins:            3,
+		Losses:          2,
+		Draws:           1,
+		Streak:          -2,
+		FavoriteOpening: tictactoe_battle.Position_POSITION_X1Y1,
+	}
+	if !proto.Equal(res, want) {
+		t.Fatalf("wanted %v but got %v", want, res)
+	}
+}
+
+func TestTicTacToeBattleExtController_ListMatchHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	startedAt := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	m := archive.Match{
+		GameID:     battle.GameID("game-1"),
+		Player:     tictactoe_battle.Player_PLAYER_B,
+		OpponentID: "player_a",
+		Outcome:    archive.OutcomeWin,
+		Reason:     archive.ReasonResign,
+		Mode:       battle.ModeGobblers,
+		Ranked:     true,
+		MoveCount:  5,
+		StartedAt:  startedAt,
+		FinishedAt: startedAt.Add(90 * time.Second),
+	}
+	playerInteractor := mock_interactors.NewMockPlayerInteractor(ctrl)
+	playerInteractor.EXPECT().ListMatchHistory(gomock.Any(), "player_b", "20", 10).
+		Return(&archive.MatchHistory{Matches: []archive.Match{m}, NextPageToken: "30"}, nil)
+
+	c := &ticTacToeBattleExtController{playerInteractor: playerInteractor}
+	req := &tictactoe_battle_ext.ListMatchHistoryRequest{LoginId: "player_b", PageToken: "20", PageSize: 10}
+	res, err := c.ListMatchHistory(context.Background(), req)
+	if err != nil {
+		t.Fatalf("failed to ListMatchHistory: %v", err)
+	}
+	if res.NextPageToken != "30" || len(res.Matches) != 1 {
+		t.Fatalf("wanted 1 match and next page token 30 but got %v", res)
+	}
+
+	got := res.Matches[0]
+	want := &tictactoe_battle_ext.Match{
+		GameId:       "game-1",
+		Player:       tictactoe_battle.Player_PLAYER_B,
+		OpponentId:   "player_a",
+		Outcome:      "win",
+		Reason:       "resign",
+		Mode:         "gobblers",
+		Ranked:       true,
+		MoveCount:    5,
+		StartedAtMs:  1622548800000,
+		FinishedAtMs: 1622548890000,
+	}
+	if !proto.Equal(got, want) {
+		t.Fatalf("wanted %v but got %v", want, got)
+	}
+}
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/listener"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"golang.org/x/xerrors"
+)
+
+// defaultReplaySpeed StreamReplayで再生速度が指定されなかった場合の倍率.
+const defaultReplaySpeed = 1
+
+func (c *ticTacToeBattleExtController) GetReplay(ctx context.Context, req *tictactoe_battle_ext.GetReplayRequest) (*tictactoe_battle_ext.GetReplayResponse, error) {
+	situations, err := c.replayInteractor.GetReplay(ctx, battle.GameID(req.GameId), loginFromContext(ctx).LoginId)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to GetReplay: %w", err)
+	}
+
+	return &tictactoe_battle_ext.GetReplayResponse{Situations: situations}, nil
+}
+
+func (c *ticTacToeBattleExtController) StreamReplay(req *tictactoe_battle_ext.StreamReplayRequest, stream tictactoe_battle_ext.TicTacToeBattleExtService_StreamReplayServer) error {
+	ctx := loggers.LoggerToContext(stream.Context(), c.logger)
+	speed := req.Speed
+	if speed == 0 {
+		speed = defaultReplaySpeed
+	}
+
+	lsnr, err := c.replayInteractor.StreamReplay(ctx, battle.GameID(req.GameId), loginFromContext(ctx).LoginId, speed)
+	if err != nil {
+		return xerrors.Errorf("failed to StreamReplay: %w", err)
+	}
+
+	for {
+		bs, err := lsnr.Listen(ctx)
+		if err != nil {
+			if xerrors.Is(err, listener.ReplayEndedError) || xerrors.Is(err, context.Canceled) {
+				return nil
+			}
+			return xerrors.Errorf("failed to Listen: %w", err)
+		}
+		if err := stream.Send(bs); err != nil {
+			if xerrors.Is(err, context.Canceled) {
+				loggers.Logger(ctx).Debug("client context canceled")
+				return nil
+			}
+
+			return xerrors.Errorf("failed to Send: %w", err)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	mock_interactors "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/interactors"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/listener"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"google.golang.org/grpc"
+)
+
+type (
+	// fakeReplayStream 送信されたBattleSituationを記録する.
+	fakeReplayStream struct {
+		grpc.ServerStream
+		ctx  context.Context
+		sent []*tictactoe_battle_ext.BattleSituation
+	}
+)
+
+func (s *fakeReplayStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeReplayStream) Send(bs *tictactoe_battle_ext.BattleSituation) error {
+	s.sent = append(s.sent, bs)
+	return nil
+}
+
+func TestTicTacToeBattleExtController_StreamReplay(t *testing.T) {
+	const gameID = battle.GameID("room-1")
+	login := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+
+	tests := []struct {
+		name      string
+		speed     float64
+		wantSpeed float64
+	}{
+		{name: "default speed", speed: 0, wantSpeed: defaultReplaySpeed},
+		{name: "fast forward", speed: 4, wantSpeed: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			frames := []listener.ReplayFrame{
+				{Situation: &tictactoe_battle_ext.BattleSituation{}},
+				{Situation: &tictactoe_battle_ext.BattleSituation{}},
+			}
+			replayInteractor := mock_interactors.NewMockReplayInteractor(ctrl)
+			replayInteractor.EXPECT().StreamReplay(gomock.Any(), gameID, login.LoginId, tt.wantSpeed).
+				Return(listener.NewReplayListener(frames, tt.wantSpeed), nil)
+
+			c := &ticTacToeBattleExtController{replayInteractor: replayInteractor}
+			stream := &fakeReplayStream{ctx: context.WithValue(context.Background(), loginContextKey{}, login)}
+			req := &tictactoe_battle_ext.StreamReplayRequest{GameId: gameID.String(), Speed: tt.speed}
+			if err := c.StreamReplay(req, stream); err != nil {
+				t.Fatalf("failed to StreamReplay: %v", err)
+			}
+			if len(stream.sent) != len(frames) {
+				t.Fatalf("wanted %d situations but got %d", len(frames), len(stream.sent))
+			}
+		})
+	}
+}
@@ -3,5 +3,6 @@ package gateways
 type (
 	Factory interface {
 		MemDBClient() MemDBClient
+		SQLClient() SQLClient
 	}
 )
@@ -16,9 +16,32 @@ type (
 		SAdd(ctx context.Context, key string, values ...interface{}) error
 		SRem(ctx context.Context, key string, members ...interface{}) error
 		SMembers(ctx context.Context, key string) ([]string, error)
-		PublishStream(ctx context.Context, streamKey string, messages map[string]interface{}) error
+		// PublishStream streamの最新のmessage idがpreviousIDと一致する場合のみ追加する.
+		//   previousIDが空文字の場合はstreamが存在しないことを条件とする. 一致しない場合はConflictError.
+		//   boundaryKeyを持つmessageを追加してmaxLenを超えた場合のみ、古いmessageをboundaryKeyを持つmessageの直前まで削除する.
+		//   削除後にmaxLen以下となる境界が無い場合は削除しない.
+		PublishStream(ctx context.Context, streamKey, previousID string, maxLen int64, boundaryKey string, messages map[string]interface{}) (id string, err error)
 		ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (id, message string, err error)
 		ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (id, message string, err error)
+		// RangeStream stream内の全てのmessageからmessageKeyの値を古い順に返す. messageKeyを持たないmessageは除く.
+		RangeStream(ctx context.Context, streamKey, messageKey string) ([]string, error)
 		Expire(ctx context.Context, key string, duration time.Duration) error
+		Publish(ctx context.Context, channel string, message interface{}) error
+		// Subscribe channelを購読する. 返却されるchannelはctxの終了時にcloseされる.
+		Subscribe(ctx context.Context, channel string) (<-chan string, error)
+		// ZAdd sorted setにmembersを追加する. 既に存在するmemberはscoreを更新する.
+		ZAdd(ctx context.Context, key string, members ...ZMember) error
+		// ZRevRangeWithScores scoreの高い順でstartからstopまで(0始まり、両端を含む)のmemberを返す.
+		//   stopが負の場合は末尾から数える. scoreが同じmemberはmemberの降順.
+		ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) ([]ZMember, error)
+		// ZRevRank scoreの高い順でのmemberの順位(0始まり)を返す. 存在しない場合はNotFoundError.
+		ZRevRank(ctx context.Context, key, member string) (int64, error)
+	}
+
+	// ZMember is
+	//   sorted setのmemberとscore.
+	ZMember struct {
+		Member string
+		Score  float64
 	}
 )
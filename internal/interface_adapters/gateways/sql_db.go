@@ -0,0 +1,30 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/mocks/$GOPACKAGE/mock_$GOFILE -package=mock_$GOPACKAGE
+package gateways
+
+import (
+	"context"
+)
+
+type (
+	// SQLClient is
+	//   roomの削除後も残す記録を保存するSQL database. queryはSQLiteの構文で記述する.
+	SQLClient interface {
+		Ping(ctx context.Context) error
+		// Exec queryを実行し、変更された行数を返す.
+		Exec(ctx context.Context, query string, args ...interface{}) (int64, error)
+		// Query queryを実行する. 返却されたSQLRowsは呼び出し側でCloseすること.
+		Query(ctx context.Context, query string, args ...interface{}) (SQLRows, error)
+		// Transaction fnを1つのtransactionとして実行し、fnがエラーを返した場合はrollbackする.
+		//   fnの中ではtxのみを使用すること. transactionの中で呼び出した場合はそのtransactionでfnを実行する.
+		Transaction(ctx context.Context, fn func(tx SQLClient) error) error
+	}
+
+	// SQLRows is
+	//   Queryの結果. *sql.Rowsが満たす.
+	SQLRows interface {
+		Next() bool
+		Scan(dest ...interface{}) error
+		Err() error
+		Close() error
+	}
+)
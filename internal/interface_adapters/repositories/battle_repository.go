@@ -3,33 +3,45 @@ package repositories
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/xerrors"
 )
 
 const (
-	battleMessageKey = "tic_tac_toe_battle_message_key"
+	battleMessageKey    = "tic_tac_toe_battle_message_key"
+	moveMessageKey      = "tic_tac_toe_move_message_key"
+	gameStartMessageKey = "tic_tac_toe_game_start_message_key"
+
+	// streamMaxLen roomのstreamに保持するmessageの最大数. 終了したゲームのmoveはReplayRepositoryに保存されるため、
+	//   新しいゲームの開始時に古いゲームのmessageをゲーム単位で削除する. streamは常にゲームの先頭のmoveから始まり、
+	//   残ったmoveからroomを再構築できる.
+	streamMaxLen = 1000
 )
 
 type (
 	battleRepository struct {
-		memDBCli gateways.MemDBClient
+		memDBCli     gateways.MemDBClient
+		streamMaxLen int64
 	}
 )
 
 func NewBattleRepository(gwFactory gateways.Factory) ports.BattleRepository {
 	return &battleRepository{
-		memDBCli: gwFactory.MemDBClient(),
+		memDBCli:     gwFactory.MemDBClient(),
+		streamMaxLen: streamMaxLen,
 	}
 }
 
-func (r *battleRepository) Create(ctx context.Context, battle *battle.Battle) (room.ID, error) {
+func (r *battleRepository) Create(ctx context.Context, b *battle.Battle) (room.ID, error) {
 	var roomID room.ID
 	for {
 		roomID = room.NewID()
@@ -47,8 +59,13 @@ func (r *battleRepository) Create(ctx context.Context, battle *battle.Battle) (r
 		return "", xerrors.Errorf("failed to SetNX: %w", err)
 	}
 
-	battle.RoomID = roomID
-	if err := r.Update(ctx, battle); err != nil { // UpdateでもStreamがなければ新規作成される
+	b.RoomID = roomID
+	b.GameID = battle.NewGameID(roomID)
+	move := battle.NewOpen(b.RuleSet, b.TimeControl(), b.Ranked)
+	move.GameID = b.GameID
+	move.PlayedAt = time.Now()
+	move.Hash = battle.PositionHash(b)
+	if err := r.Update(ctx, "", b, move); err != nil { // previousIDが空の場合はStreamが新規作成される
 		return "", err
 	}
 
@@ -90,7 +107,7 @@ func (r *battleRepository) refreshRoomDuration(ctx context.Context, roomID room.
 	return nil
 }
 
-func (r *battleRepository) Update(ctx context.Context, battle *battle.Battle) error {
+func (r *battleRepository) Update(ctx context.Context, previousID string, battle *battle.Battle, move *battle.Move) error {
 	if err := r.refreshRoomDuration(ctx, battle.RoomID); err != nil {
 		return xerrors.Errorf("failed to refreshRoomDuration: %w", err)
 	}
@@ -99,13 +116,29 @@ func (r *battleRepository) Update(ctx context.Context, battle *battle.Battle) er
 	if err != nil {
 		return xerrors.Errorf("failed to json.Marshal: %w", err)
 	}
-
-	if err := r.memDBCli.PublishStream(ctx, battle.RoomID.StreamKey(), map[string]interface{}{
+	messages := map[string]interface{}{
 		battleMessageKey: jm,
-	}); err != nil {
+	}
+
+	if move != nil {
+		mm, err := json.Marshal(move)
+		if err != nil {
+			return xerrors.Errorf("failed to json.Marshal: %w", err)
+		}
+		messages[moveMessageKey] = mm
+		if move.StartsGame() {
+			messages[gameStartMessageKey] = "1"
+		}
+	}
+
+	if _, err := r.memDBCli.PublishStream(ctx, battle.RoomID.StreamKey(), previousID, r.streamMaxLen, gameStartMessageKey, messages); err != nil {
 		return xerrors.Errorf("failed to PublishStream: %w", err)
 	}
 
+	if err := r.publishEvent(ctx, battle.RoomID, room.Event{Type: room.EventBattleUpdated}); err != nil {
+		return xerrors.Errorf("failed to publishEvent: %w", err)
+	}
+
 	return nil
 }
 
@@ -118,6 +151,10 @@ func (r *battleRepository) Enter(ctx context.Context, roomID room.ID, loginID st
 		return xerrors.Errorf("failed to SAdd room member from memdb: %w", err)
 	}
 
+	if err := r.publishEvent(ctx, roomID, room.Event{Type: room.EventMemberEntered, LoginID: loginID}); err != nil {
+		return xerrors.Errorf("failed to publishEvent: %w", err)
+	}
+
 	return nil
 }
 
@@ -130,6 +167,10 @@ func (r *battleRepository) Leave(ctx context.Context, roomID room.ID, loginID st
 		return xerrors.Errorf("failed to SRem from memdb: %w", err)
 	}
 
+	if err := r.publishEvent(ctx, roomID, room.Event{Type: room.EventMemberLeft, LoginID: loginID}); err != nil {
+		return xerrors.Errorf("failed to publishEvent: %w", err)
+	}
+
 	return nil
 }
 
@@ -143,14 +184,22 @@ func (r *battleRepository) ReadStreamLatest(ctx context.Context, roomID room.ID)
 	return msgID, result, err
 }
 
-func (r *battleRepository) ReadStream(ctx context.Context, roomID room.ID, messageID string) (string, *battle.Battle, error) {
-	msgID, msg, err := r.memDBCli.ReadStream(ctx, roomID.StreamKey(), battleMessageKey, messageID)
+func (r *battleRepository) ListMoves(ctx context.Context, roomID room.ID) ([]*battle.Move, error) {
+	msgs, err := r.memDBCli.RangeStream(ctx, roomID.StreamKey(), moveMessageKey)
 	if err != nil {
-		return "", nil, xerrors.Errorf("failed to ReadStream: %w", err)
+		return nil, xerrors.Errorf("failed to RangeStream: %w", err)
 	}
 
-	result, err := unmarshal(msg)
-	return msgID, result, err
+	moves := make([]*battle.Move, 0, len(msgs))
+	for _, msg := range msgs {
+		var m battle.Move
+		if err := json.Unmarshal([]byte(msg), &m); err != nil {
+			return nil, xerrors.Errorf("failed to json unmarshal. err: %w, msg: %s", err, msg)
+		}
+		moves = append(moves, &m)
+	}
+
+	return moves, nil
 }
 
 func unmarshal(message string) (*battle.Battle, error) {
@@ -200,5 +249,48 @@ func (r *battleRepository) Delete(ctx context.Context, roomID room.ID) error {
 		return xerrors.Errorf("failed to Del from memdb: %w", err)
 	}
 
+	if err := r.publishEvent(ctx, roomID, room.Event{Type: room.EventClosed}); err != nil {
+		return xerrors.Errorf("failed to publishEvent: %w", err)
+	}
+
+	return nil
+}
+
+func (r *battleRepository) Subscribe(ctx context.Context, roomID room.ID) (<-chan room.Event, error) {
+	msgs, err := r.memDBCli.Subscribe(ctx, roomID.EventKey())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Subscribe: %w", err)
+	}
+
+	events := make(chan room.Event)
+	go func() {
+		defer close(events)
+		for msg := range msgs {
+			var ev room.Event
+			if err := json.Unmarshal([]byte(msg), &ev); err != nil {
+				loggers.Logger(ctx).Warn("failed to json unmarshal room event", zap.String("message", msg), zap.Error(err))
+				continue
+			}
+			select {
+			case events <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func (r *battleRepository) publishEvent(ctx context.Context, roomID room.ID, ev room.Event) error {
+	jm, err := json.Marshal(ev)
+	if err != nil {
+		return xerrors.Errorf("failed to json.Marshal: %w", err)
+	}
+
+	if err := r.memDBCli.Publish(ctx, roomID.EventKey(), jm); err != nil {
+		return xerrors.Errorf("failed to Publish: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/memory"
+)
+
+func TestBattleRepository_TrimAtGameStart(t *testing.T) {
+	ctx := context.Background()
+	rule := battle.NewRule()
+	r := &battleRepository{memDBCli: memory.NewMemoryClient(), streamMaxLen: 5}
+
+	b := rule.OpenBattle(battle.DefaultRuleSet())
+	b.Ranked = true
+	roomID, err := r.Create(ctx, b)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+
+	play := func(m *battle.Move) {
+		t.Helper()
+
+		id, cur, err := r.ReadStreamLatest(ctx, roomID)
+		if err != nil {
+			t.Fatalf("failed to ReadStreamLatest: %v", err)
+		}
+		if err := battle.Apply(rule, cur, m); err != nil {
+			t.Fatalf("failed to Apply: %v", err)
+		}
+		m.PlayedAt = time.Now()
+		m.Hash = battle.PositionHash(cur)
+		if err := r.Update(ctx, id, cur, m); err != nil {
+			t.Fatalf("failed to Update: %v", err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if i > 0 {
+			play(battle.NewReset(b.RuleSet, b.TimeControl(), b.Ranked))
+		}
+		play(battle.NewDeclaration("player_a"))
+		play(battle.NewDeclaration("player_b"))
+		play(battle.NewAttack(tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L))
+		play(battle.NewAttack(tictactoe_battle.Player_PLAYER_B, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L))
+	}
+
+	moves, err := r.ListMoves(ctx, roomID)
+	if err != nil {
+		t.Fatalf("failed to ListMoves: %v", err)
+	}
+	// 上限を超えた最初のゲームはResetの直前まで削除される
+	if len(moves) != 5 || moves[0].Type != battle.MoveReset {
+		t.Fatalf("wanted 5 moves from the reset but got %d moves from %d", len(moves), moves[0].Type)
+	}
+
+	replayed, err := battle.Replay(rule, moves)
+	if err != nil {
+		t.Fatalf("failed to Replay: %v", err)
+	}
+	_, latest, err := r.ReadStreamLatest(ctx, roomID)
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	if !replayed.Ranked || replayed.State != latest.State || battle.PositionHash(replayed) != battle.PositionHash(latest) {
+		t.Fatalf("wanted the latest battle to be rebuilt but got ranked: %t, state: %d", replayed.Ranked, replayed.State)
+	}
+}
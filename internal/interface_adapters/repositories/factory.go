@@ -7,15 +7,27 @@ import (
 
 type (
 	factory struct {
-		loginRepository  ports.LoginRepository
-		battleRepository ports.BattleRepository
+		loginRepository       ports.LoginRepository
+		battleRepository      ports.BattleRepository
+		replayRepository      ports.ReplayRepository
+		rateLimitRepository   ports.RateLimitRepository
+		archiveRepository     ports.GameArchiveRepository
+		ratingRepository      ports.RatingRepository
+		leaderboardRepository ports.LeaderboardRepository
+		seasonRepository      ports.SeasonRepository
 	}
 )
 
 func NewFactory(gwFactory gateways.Factory) ports.RepositoriesFactory {
 	return &factory{
-		loginRepository:  NewLoginRepository(gwFactory),
-		battleRepository: NewBattleRepository(gwFactory),
+		loginRepository:       NewLoginRepository(gwFactory),
+		battleRepository:      NewBattleRepository(gwFactory),
+		replayRepository:      NewReplayRepository(gwFactory),
+		rateLimitRepository:   NewRateLimitRepository(gwFactory),
+		archiveRepository:     NewGameArchiveRepository(gwFactory),
+		ratingRepository:      NewRatingRepository(gwFactory),
+		leaderboardRepository: NewLeaderboardRepository(gwFactory),
+		seasonRepository:      NewSeasonRepository(gwFactory),
 	}
 }
 
@@ -26,3 +38,27 @@ func (f *factory) LoginRepository() ports.LoginRepository {
 func (f *factory) BattleRepository() ports.BattleRepository {
 	return f.battleRepository
 }
+
+func (f *factory) ReplayRepository() ports.ReplayRepository {
+	return f.replayRepository
+}
+
+func (f *factory) RateLimitRepository() ports.RateLimitRepository {
+	return f.rateLimitRepository
+}
+
+func (f *factory) GameArchiveRepository() ports.GameArchiveRepository {
+	return f.archiveRepository
+}
+
+func (f *factory) RatingRepository() ports.RatingRepository {
+	return f.ratingRepository
+}
+
+func (f *factory) LeaderboardRepository() ports.LeaderboardRepository {
+	return f.leaderboardRepository
+}
+
+func (f *factory) SeasonRepository() ports.SeasonRepository {
+	return f.seasonRepository
+}
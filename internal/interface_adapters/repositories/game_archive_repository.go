@@ -0,0 +1,241 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"golang.org/x/xerrors"
+)
+
+const (
+	gameColumns = `game_id, room_id, player_a_id, player_b_id, rule_set, time_control, ranked,
+		result, reason, win_lines, move_count, moves, started_at, finished_at`
+	// summaryColumns gameColumnsのmovesをnullに置き換えたもの. 一覧では保存時に数えたmove_countを使い、movesは読み込まない.
+	summaryColumns = `game_id, room_id, player_a_id, player_b_id, rule_set, time_control, ranked,
+		result, reason, win_lines, move_count, 'null' AS moves, started_at, finished_at`
+
+	// outcomeColumn 1つ目と2つ目の引数のプレイヤーから見たゲームの結果.
+	outcomeColumn = `CASE
+		WHEN result = '` + string(archive.ResultDraw) + `' THEN '` + string(archive.OutcomeDraw) + `'
+		WHEN (result = '` + string(archive.ResultPlayerAWin) + `' AND player_a_id = ?)
+			OR (result = '` + string(archive.ResultPlayerBWin) + `' AND player_b_id = ?) THEN '` + string(archive.OutcomeWin) + `'
+		ELSE '` + string(archive.OutcomeLoss) + `' END`
+)
+
+type (
+	gameArchiveRepository struct {
+		sqlCli gateways.SQLClient
+	}
+)
+
+func NewGameArchiveRepository(gwFactory gateways.Factory) ports.GameArchiveRepository {
+	return &gameArchiveRepository{
+		sqlCli: gwFactory.SQLClient(),
+	}
+}
+
+func (r *gameArchiveRepository) Save(ctx context.Context, game *archive.Game) error {
+	var columns [4][]byte
+	for i, v := range []interface{}{game.RuleSet, game.TimeControl, game.WinLines, game.Moves} {
+		j, err := json.Marshal(v)
+		if err != nil {
+			return xerrors.Errorf("failed to json.Marshal: %w", err)
+		}
+		columns[i] = j
+	}
+
+	// 最初に駒を置いたマスはFavoriteOpeningで集計するため、movesとは別に保存する
+	var openings [2]interface{}
+	for i, player := range []tictactoe_battle.Player{tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Player_PLAYER_B} {
+		if pos, ok := game.Opening(player); ok {
+			openings[i] = int32(pos)
+		}
+	}
+
+	if _, err := r.sqlCli.Exec(ctx, `INSERT OR REPLACE INTO games (`+gameColumns+`, player_a_opening, player_b_opening)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		game.GameID.String(), game.RoomID.String(), game.PlayerAID, game.PlayerBID,
+		string(columns[0]), string(columns[1]), game.Ranked,
+		string(game.Result), string(game.Reason), string(columns[2]), game.MoveCount, string(columns[3]),
+		game.StartedAt.UTC(), game.FinishedAt.UTC(), openings[0], openings[1],
+	); err != nil {
+		return xerrors.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gameArchiveRepository) Find(ctx context.Context, gameID battle.GameID) (*archive.Game, error) {
+	games, err := r.query(ctx, `SELECT `+gameColumns+` FROM games WHERE game_id = ?`, gameID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(games) == 0 {
+		return nil, exceptions.NewNotFoundError(fmt.Sprintf("game %s is not archived", gameID))
+	}
+
+	return games[0], nil
+}
+
+func (r *gameArchiveRepository) ListByPlayer(ctx context.Context, playerID string, offset, limit int) ([]*archive.Game, error) {
+	// SQLiteでは負のLIMITは上限なしとなる
+	if limit <= 0 {
+		limit = -1
+	}
+
+	games, err := r.query(ctx, `SELECT `+summaryColumns+` FROM games
+		WHERE player_a_id = ? OR player_b_id = ?
+		ORDER BY finished_at DESC, game_id DESC LIMIT ? OFFSET ?`,
+		playerID, playerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return games, nil
+}
+
+func (r *gameArchiveRepository) ListUnrated(ctx context.Context, limit int) ([]*archive.Game, error) {
+	games, err := r.query(ctx, `SELECT `+gameColumns+` FROM games
+		WHERE ranked = 1 AND NOT EXISTS (SELECT 1 FROM game_ratings WHERE game_ratings.game_id = games.game_id)
+		ORDER BY finished_at, game_id LIMIT ?`,
+		limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return games, nil
+}
+
+func (r *gameArchiveRepository) CountOutcomes(ctx context.Context, playerID string) (map[archive.Outcome]int, error) {
+	rows, err := r.sqlCli.Query(ctx, `SELECT outcome, COUNT(*) FROM (
+			SELECT `+outcomeColumn+` AS outcome FROM games WHERE player_a_id = ? OR player_b_id = ?
+		) GROUP BY outcome`,
+		playerID, playerID, playerID, playerID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Query: %w", err)
+	}
+	defer rows.Close()
+
+	ret := make(map[archive.Outcome]int)
+	for rows.Next() {
+		var (
+			outcome string
+			count   int
+		)
+		if err := rows.Scan(&outcome, &count); err != nil {
+			return nil, xerrors.Errorf("failed to Scan: %w", err)
+		}
+		ret[archive.Outcome(outcome)] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read rows: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r *gameArchiveRepository) ListOutcomes(ctx context.Context, playerID string, offset, limit int) ([]archive.Outcome, error) {
+	rows, err := r.sqlCli.Query(ctx, `SELECT `+outcomeColumn+` FROM games
+		WHERE player_a_id = ? OR player_b_id = ?
+		ORDER BY finished_at DESC, game_id DESC LIMIT ? OFFSET ?`,
+		playerID, playerID, playerID, playerID, limit, offset)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Query: %w", err)
+	}
+	defer rows.Close()
+
+	ret := make([]archive.Outcome, 0, limit)
+	for rows.Next() {
+		var outcome string
+		if err := rows.Scan(&outcome); err != nil {
+			return nil, xerrors.Errorf("failed to Scan: %w", err)
+		}
+		ret = append(ret, archive.Outcome(outcome))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read rows: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r *gameArchiveRepository) FavoriteOpening(ctx context.Context, playerID string) (tictactoe_battle.Position, error) {
+	rows, err := r.sqlCli.Query(ctx, `SELECT opening FROM (
+			SELECT player_a_opening AS opening FROM games WHERE player_a_id = ? AND player_a_opening IS NOT NULL
+			UNION ALL
+			SELECT player_b_opening AS opening FROM games WHERE player_b_id = ? AND player_b_opening IS NOT NULL
+		) GROUP BY opening ORDER BY COUNT(*) DESC, opening LIMIT 1`,
+		playerID, playerID)
+	if err != nil {
+		return tictactoe_battle.Position_POSITION_UNDEFINED, xerrors.Errorf("failed to Query: %w", err)
+	}
+	defer rows.Close()
+
+	ret := tictactoe_battle.Position_POSITION_UNDEFINED
+	if rows.Next() {
+		var opening int32
+		if err := rows.Scan(&opening); err != nil {
+			return tictactoe_battle.Position_POSITION_UNDEFINED, xerrors.Errorf("failed to Scan: %w", err)
+		}
+		ret = tictactoe_battle.Position(opening)
+	}
+	if err := rows.Err(); err != nil {
+		return tictactoe_battle.Position_POSITION_UNDEFINED, xerrors.Errorf("failed to read rows: %w", err)
+	}
+
+	return ret, nil
+}
+
+// query gameColumnsまたはsummaryColumnsを選択するqueryを実行し、結果をarchive.Gameに変換する.
+func (r *gameArchiveRepository) query(ctx context.Context, query string, args ...interface{}) ([]*archive.Game, error) {
+	rows, err := r.sqlCli.Query(ctx, query, args...)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Query: %w", err)
+	}
+	defer rows.Close()
+
+	var ret []*archive.Game
+	for rows.Next() {
+		var (
+			g                                     archive.Game
+			gameID, roomID, result, reason        string
+			ruleSet, timeControl, winLines, moves string
+		)
+		if err := rows.Scan(&gameID, &roomID, &g.PlayerAID, &g.PlayerBID, &ruleSet, &timeControl, &g.Ranked,
+			&result, &reason, &winLines, &g.MoveCount, &moves, &g.StartedAt, &g.FinishedAt); err != nil {
+			return nil, xerrors.Errorf("failed to Scan: %w", err)
+		}
+		g.GameID = battle.GameID(gameID)
+		g.RoomID = room.ID(roomID)
+		g.Result = archive.Result(result)
+		g.Reason = archive.Reason(reason)
+
+		for _, c := range []struct {
+			raw  string
+			dest interface{}
+		}{
+			{ruleSet, &g.RuleSet},
+			{timeControl, &g.TimeControl},
+			{winLines, &g.WinLines},
+			{moves, &g.Moves},
+		} {
+			if err := json.Unmarshal([]byte(c.raw), c.dest); err != nil {
+				return nil, xerrors.Errorf("failed to json unmarshal. err: %w, game: %s", err, gameID)
+			}
+		}
+		ret = append(ret, &g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read rows: %w", err)
+	}
+
+	return ret, nil
+}
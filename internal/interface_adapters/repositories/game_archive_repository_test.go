@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/infrastructures/sqlite"
+)
+
+func TestGameArchiveRepository_ListByPlayer(t *testing.T) {
+	cli, err := sqlite.NewSQLiteClient(sqlite.Config{Path: ":memory:"})
+	if err != nil {
+		t.Fatalf("failed to NewSQLiteClient: %v", err)
+	}
+	r := &gameArchiveRepository{sqlCli: cli}
+	ctx := context.Background()
+
+	rule := battle.NewRule()
+	b := rule.OpenBattle(battle.DefaultRuleSet())
+	var moves []*battle.Move
+	for _, m := range []*battle.Move{
+		battle.NewDeclaration("player_a"),
+		battle.NewDeclaration("player_b"),
+		battle.NewAttack(tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X0Y0, tictactoe_battle.Piece_PIECE_L),
+		battle.NewResign(tictactoe_battle.Player_PLAYER_B),
+	} {
+		if err := battle.Apply(rule, b, m); err != nil {
+			t.Fatalf("failed to Apply: %v", err)
+		}
+		m.PlayedAt = time.Date(2021, 7, 1, 0, 0, len(moves), 0, time.UTC)
+		moves = append(moves, m)
+	}
+	b.GameID = battle.NewGameID(b.RoomID)
+	if err := r.Save(ctx, archive.NewGame(b, moves)); err != nil {
+		t.Fatalf("failed to Save: %v", err)
+	}
+
+	// 一覧ではmovesを読み込まず、保存時のmove_countを返す
+	games, err := r.ListByPlayer(ctx, "player_b", 0, 0)
+	if err != nil {
+		t.Fatalf("failed to ListByPlayer: %v", err)
+	}
+	if len(games) != 1 || games[0].MoveCount != 1 || games[0].Moves != nil {
+		t.Fatalf("wanted 1 game of 1 move without moves but got %+v", games)
+	}
+
+	game, err := r.Find(ctx, b.GameID)
+	if err != nil {
+		t.Fatalf("failed to Find: %v", err)
+	}
+	if len(game.Moves) != len(moves) {
+		t.Fatalf("wanted %d moves but got %d", len(moves), len(game.Moves))
+	}
+}
@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/leaderboard"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"golang.org/x/xerrors"
+)
+
+const (
+	leaderboardKeyPrefix = "tic_tac_toe_leaderboard"
+)
+
+type (
+	leaderboardRepository struct {
+		memDBCli gateways.MemDBClient
+	}
+)
+
+func NewLeaderboardRepository(gwFactory gateways.Factory) ports.LeaderboardRepository {
+	return &leaderboardRepository{
+		memDBCli: gwFactory.MemDBClient(),
+	}
+}
+
+func (r *leaderboardRepository) Record(ctx context.Context, season int, playerID string, rating float64, at time.Time) error {
+	for _, board := range leaderboard.Boards() {
+		key := leaderboardKey(season, board, at)
+		if err := r.memDBCli.ZAdd(ctx, key, gateways.ZMember{Member: playerID, Score: rating}); err != nil {
+			return xerrors.Errorf("failed to ZAdd: %w", err)
+		}
+		if retention := board.Retention(); retention > 0 {
+			if err := r.memDBCli.Expire(ctx, key, retention); err != nil {
+				return xerrors.Errorf("failed to Expire: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *leaderboardRepository) List(ctx context.Context, season int, board leaderboard.Board, at time.Time, offset, limit int) ([]leaderboard.Entry, error) {
+	stop := int64(-1)
+	if limit > 0 {
+		stop = int64(offset + limit - 1)
+	}
+
+	members, err := r.memDBCli.ZRevRangeWithScores(ctx, leaderboardKey(season, board, at), int64(offset), stop)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ZRevRangeWithScores: %w", err)
+	}
+
+	entries := make([]leaderboard.Entry, 0, len(members))
+	for i, m := range members {
+		entries = append(entries, leaderboard.Entry{Rank: offset + i + 1, PlayerID: m.Member, Rating: m.Score})
+	}
+	return entries, nil
+}
+
+func (r *leaderboardRepository) Rank(ctx context.Context, season int, board leaderboard.Board, at time.Time, playerID string) (*leaderboard.Entry, error) {
+	key := leaderboardKey(season, board, at)
+	rank, err := r.memDBCli.ZRevRank(ctx, key, playerID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ZRevRank: %w", err)
+	}
+
+	// 順位とratingを別に取得するため、間に順位が変わった場合は競合とする
+	members, err := r.memDBCli.ZRevRangeWithScores(ctx, key, rank, rank)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ZRevRangeWithScores: %w", err)
+	}
+	if len(members) == 0 || members[0].Member != playerID {
+		return nil, exceptions.NewConflictError(fmt.Sprintf("leaderboard has been updated. player: %s", playerID))
+	}
+
+	return &leaderboard.Entry{Rank: int(rank) + 1, PlayerID: playerID, Rating: members[0].Score}, nil
+}
+
+func (r *leaderboardRepository) Clear(ctx context.Context, season int) error {
+	if err := r.memDBCli.Del(ctx, leaderboardKey(season, leaderboard.BoardAllTime, time.Time{})); err != nil {
+		return xerrors.Errorf("failed to Del: %w", err)
+	}
+	return nil
+}
+
+// leaderboardKey atを含む期間のboardの順位表のkey. seasonの終了で空になるのは通算の順位表のみのため、
+//   日別と週別のkeyにはseasonを含めない.
+func leaderboardKey(season int, board leaderboard.Board, at time.Time) string {
+	if period := board.Period(at); period != "" {
+		return fmt.Sprintf("%s:%s:%s", leaderboardKeyPrefix, board, period)
+	}
+	return fmt.Sprintf("%s:%d:%s", leaderboardKeyPrefix, season, board)
+}
@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"golang.org/x/xerrors"
+)
+
+const (
+	rateLimitKeyPrefix = "tic_tac_toe_rate_limit"
+)
+
+type (
+	rateLimitRepository struct {
+		memDBCli gateways.MemDBClient
+	}
+)
+
+func NewRateLimitRepository(gwFactory gateways.Factory) ports.RateLimitRepository {
+	return &rateLimitRepository{
+		memDBCli: gwFactory.MemDBClient(),
+	}
+}
+
+func (r *rateLimitRepository) Allow(ctx context.Context, key string, interval time.Duration) (bool, error) {
+	_, err := r.memDBCli.Get(ctx, rateLimitKey(key))
+	if err == nil {
+		return false, nil
+	}
+	if !exceptions.IsNotFoundError(err) {
+		return false, xerrors.Errorf("failed to memdb get: %w", err)
+	}
+
+	if err := r.memDBCli.Set(ctx, rateLimitKey(key), "", interval); err != nil {
+		return false, xerrors.Errorf("failed to Set: %w", err)
+	}
+
+	return true, nil
+}
+
+func rateLimitKey(key string) string {
+	return fmt.Sprintf("%s:%s", rateLimitKeyPrefix, key)
+}
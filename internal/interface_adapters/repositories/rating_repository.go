@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"golang.org/x/xerrors"
+)
+
+type (
+	ratingRepository struct {
+		sqlCli gateways.SQLClient
+	}
+)
+
+func NewRatingRepository(gwFactory gateways.Factory) ports.RatingRepository {
+	return &ratingRepository{
+		sqlCli: gwFactory.SQLClient(),
+	}
+}
+
+func (r *ratingRepository) Find(ctx context.Context, playerID string) (*rating.Rating, error) {
+	rows, err := r.sqlCli.Query(ctx, `SELECT rating, rd, volatility, games, updated_at FROM ratings WHERE player_id = ?`, playerID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Query: %w", err)
+	}
+	defer rows.Close()
+
+	ret := rating.NewRating(playerID)
+	for rows.Next() {
+		if err := rows.Scan(&ret.Rating, &ret.RD, &ret.Volatility, &ret.Games, &ret.UpdatedAt); err != nil {
+			return nil, xerrors.Errorf("failed to Scan: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read rows: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r *ratingRepository) FindResult(ctx context.Context, gameID battle.GameID) (*rating.Result, error) {
+	rows, err := r.sqlCli.Query(ctx, `SELECT player_a_before, player_a_after, player_b_before, player_b_after
+		FROM game_ratings WHERE game_id = ?`, gameID.String())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Query: %w", err)
+	}
+	defer rows.Close()
+
+	var ret *rating.Result
+	for rows.Next() {
+		ret = &rating.Result{}
+		if err := rows.Scan(&ret.PlayerA.Before, &ret.PlayerA.After, &ret.PlayerB.Before, &ret.PlayerB.After); err != nil {
+			return nil, xerrors.Errorf("failed to Scan: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read rows: %w", err)
+	}
+	if ret == nil {
+		return nil, exceptions.NewNotFoundError(fmt.Sprintf("game %s is not rated", gameID))
+	}
+
+	return ret, nil
+}
+
+func (r *ratingRepository) Save(ctx context.Context, gameID battle.GameID, result *rating.Result, ratings ...*rating.Rating) error {
+	return r.sqlCli.Transaction(ctx, func(tx gateways.SQLClient) error {
+		// 同じゲームを重複して反映しないよう、結果と同じtransactionで記録する
+		n, err := tx.Exec(ctx, `INSERT OR IGNORE INTO game_ratings
+			(game_id, player_a_before, player_a_after, player_b_before, player_b_after, rated_at)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+			gameID.String(), result.PlayerA.Before, result.PlayerA.After, result.PlayerB.Before, result.PlayerB.After, time.Now().UTC())
+		if err != nil {
+			return xerrors.Errorf("failed to Exec: %w", err)
+		}
+		if n == 0 {
+			return exceptions.NewConflictError(fmt.Sprintf("game %s has already been rated", gameID))
+		}
+
+		for _, rt := range ratings {
+			// 初めての対局では行が無いため追加し、既に追加されていれば競合とする
+			if rt.Games == 1 {
+				n, err = tx.Exec(ctx, `INSERT OR IGNORE INTO ratings (player_id, rating, rd, volatility, games, updated_at)
+					VALUES (?, ?, ?, ?, ?, ?)`,
+					rt.PlayerID, rt.Rating, rt.RD, rt.Volatility, rt.Games, rt.UpdatedAt.UTC())
+			} else {
+				n, err = tx.Exec(ctx, `UPDATE ratings SET rating = ?, rd = ?, volatility = ?, games = ?, updated_at = ?
+					WHERE player_id = ? AND games = ?`,
+					rt.Rating, rt.RD, rt.Volatility, rt.Games, rt.UpdatedAt.UTC(), rt.PlayerID, rt.Games-1)
+			}
+			if err != nil {
+				return xerrors.Errorf("failed to Exec: %w", err)
+			}
+			if n == 0 {
+				return exceptions.NewConflictError(fmt.Sprintf("rating of %s has been updated by another game", rt.PlayerID))
+			}
+		}
+		return nil
+	})
+}
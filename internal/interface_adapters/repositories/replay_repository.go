@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"golang.org/x/xerrors"
+)
+
+const (
+	replayKeyPrefix = "tic_tac_toe_replay"
+	replayTimeout   = 24 * time.Hour
+)
+
+type (
+	replayRepository struct {
+		memDBCli gateways.MemDBClient
+	}
+)
+
+func NewReplayRepository(gwFactory gateways.Factory) ports.ReplayRepository {
+	return &replayRepository{
+		memDBCli: gwFactory.MemDBClient(),
+	}
+}
+
+func (r *replayRepository) Save(ctx context.Context, gameID battle.GameID, moves []*battle.Move) error {
+	jm, err := json.Marshal(moves)
+	if err != nil {
+		return xerrors.Errorf("failed to json.Marshal: %w", err)
+	}
+
+	if err := r.memDBCli.Set(ctx, replayKey(gameID), jm, replayTimeout); err != nil {
+		return xerrors.Errorf("failed to Set: %w", err)
+	}
+
+	return nil
+}
+
+func (r *replayRepository) FindMoves(ctx context.Context, gameID battle.GameID) ([]*battle.Move, error) {
+	msg, err := r.memDBCli.Get(ctx, replayKey(gameID))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to memdb get: %w", err)
+	}
+
+	var moves []*battle.Move
+	if err := json.Unmarshal([]byte(msg), &moves); err != nil {
+		return nil, xerrors.Errorf("failed to json unmarshal. err: %w, msg: %s", err, msg)
+	}
+
+	return moves, nil
+}
+
+func replayKey(gameID battle.GameID) string {
+	return fmt.Sprintf("%s:%s", replayKeyPrefix, gameID)
+}
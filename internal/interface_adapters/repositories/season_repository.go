@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/leaderboard"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"golang.org/x/xerrors"
+)
+
+type (
+	seasonRepository struct {
+		sqlCli gateways.SQLClient
+	}
+)
+
+func NewSeasonRepository(gwFactory gateways.Factory) ports.SeasonRepository {
+	return &seasonRepository{
+		sqlCli: gwFactory.SQLClient(),
+	}
+}
+
+func (r *seasonRepository) Current(ctx context.Context) (int, error) {
+	rows, err := r.sqlCli.Query(ctx, `SELECT COALESCE(MAX(number), 0) FROM seasons`)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to Query: %w", err)
+	}
+	defer rows.Close()
+
+	var last int
+	for rows.Next() {
+		if err := rows.Scan(&last); err != nil {
+			return 0, xerrors.Errorf("failed to Scan: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, xerrors.Errorf("failed to read rows: %w", err)
+	}
+
+	if last < leaderboard.FirstSeason {
+		return leaderboard.FirstSeason, nil
+	}
+	return last + 1, nil
+}
+
+func (r *seasonRepository) Close(ctx context.Context, number int, closedAt time.Time) error {
+	n, err := r.sqlCli.Exec(ctx, `INSERT OR IGNORE INTO seasons (number, closed_at) VALUES (?, ?)`, number, closedAt.UTC())
+	if err != nil {
+		return xerrors.Errorf("failed to Exec: %w", err)
+	}
+	if n == 0 {
+		return exceptions.NewConflictError(fmt.Sprintf("season %d has already been closed", number))
+	}
+
+	return nil
+}
+
+func (r *seasonRepository) SaveStandings(ctx context.Context, number int, standings []leaderboard.Entry) error {
+	return r.sqlCli.Transaction(ctx, func(tx gateways.SQLClient) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM season_standings WHERE season = ?`, number); err != nil {
+			return xerrors.Errorf("failed to Exec: %w", err)
+		}
+		for _, e := range standings {
+			if _, err := tx.Exec(ctx, `INSERT INTO season_standings (season, rank, player_id, rating) VALUES (?, ?, ?, ?)`,
+				number, e.Rank, e.PlayerID, e.Rating); err != nil {
+				return xerrors.Errorf("failed to Exec: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *seasonRepository) Find(ctx context.Context, number int) (*leaderboard.Season, error) {
+	rows, err := r.sqlCli.Query(ctx, `SELECT closed_at FROM seasons WHERE number = ?`, number)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Query: %w", err)
+	}
+	var closed []time.Time
+	for rows.Next() {
+		var closedAt time.Time
+		if err := rows.Scan(&closedAt); err != nil {
+			rows.Close()
+			return nil, xerrors.Errorf("failed to Scan: %w", err)
+		}
+		closed = append(closed, closedAt)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return nil, xerrors.Errorf("failed to read rows: %w", err)
+	}
+	// 接続が1つのため次のqueryの前に閉じる
+	if err := rows.Close(); err != nil {
+		return nil, xerrors.Errorf("failed to Close rows: %w", err)
+	}
+	if len(closed) == 0 {
+		return nil, exceptions.NewNotFoundError(fmt.Sprintf("season %d has not been closed", number))
+	}
+
+	// 順位はCloseで同じtransactionに記録されるため、終了したseasonでは揃っている
+	rows, err = r.sqlCli.Query(ctx, `SELECT rank, player_id, rating FROM season_standings WHERE season = ? ORDER BY rank`, number)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Query: %w", err)
+	}
+	defer rows.Close()
+
+	ret := &leaderboard.Season{Number: number, ClosedAt: closed[0], Standings: []leaderboard.Entry{}}
+	for rows.Next() {
+		var e leaderboard.Entry
+		if err := rows.Scan(&e.Rank, &e.PlayerID, &e.Rating); err != nil {
+			return nil, xerrors.Errorf("failed to Scan: %w", err)
+		}
+		ret.Standings = append(ret.Standings, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read rows: %w", err)
+	}
+
+	return ret, nil
+}
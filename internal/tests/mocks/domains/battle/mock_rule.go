@@ -6,6 +6,7 @@ package mock_battle
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	tictactoe_battle "github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
@@ -35,6 +36,35 @@ func (m *MockRule) EXPECT() *MockRuleMockRecorder {
 	return m.recorder
 }
 
+// AcceptDraw mocks base method.
+func (m *MockRule) AcceptDraw(b *battle.Battle, player tictactoe_battle.Player) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptDraw", b, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptDraw indicates an expected call of AcceptDraw.
+func (mr *MockRuleMockRecorder) AcceptDraw(b, player interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptDraw", reflect.TypeOf((*MockRule)(nil).AcceptDraw), b, player)
+}
+
+// AcceptRematch mocks base method.
+func (m *MockRule) AcceptRematch(b *battle.Battle, player tictactoe_battle.Player) (battle.Rematch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptRematch", b, player)
+	ret0, _ := ret[0].(battle.Rematch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptRematch indicates an expected call of AcceptRematch.
+func (mr *MockRuleMockRecorder) AcceptRematch(b, player interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptRematch", reflect.TypeOf((*MockRule)(nil).AcceptRematch), b, player)
+}
+
 // Attack mocks base method.
 func (m *MockRule) Attack(b *battle.Battle, player tictactoe_battle.Player, pos tictactoe_battle.Position, size tictactoe_battle.Piece) error {
 	m.ctrl.T.Helper()
@@ -63,18 +93,60 @@ func (mr *MockRuleMockRecorder) Declaration(b, playerID interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Declaration", reflect.TypeOf((*MockRule)(nil).Declaration), b, playerID)
 }
 
+// DeclineDraw mocks base method.
+func (m *MockRule) DeclineDraw(b *battle.Battle, player tictactoe_battle.Player) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineDraw", b, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineDraw indicates an expected call of DeclineDraw.
+func (mr *MockRuleMockRecorder) DeclineDraw(b, player interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineDraw", reflect.TypeOf((*MockRule)(nil).DeclineDraw), b, player)
+}
+
+// LegalMoves mocks base method.
+func (m *MockRule) LegalMoves(b *battle.Battle, player tictactoe_battle.Player) []battle.Action {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LegalMoves", b, player)
+	ret0, _ := ret[0].([]battle.Action)
+	return ret0
+}
+
+// LegalMoves indicates an expected call of LegalMoves.
+func (mr *MockRuleMockRecorder) LegalMoves(b, player interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LegalMoves", reflect.TypeOf((*MockRule)(nil).LegalMoves), b, player)
+}
+
+// OfferDraw mocks base method.
+func (m *MockRule) OfferDraw(b *battle.Battle, player tictactoe_battle.Player) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferDraw", b, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OfferDraw indicates an expected call of OfferDraw.
+func (mr *MockRuleMockRecorder) OfferDraw(b, player interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferDraw", reflect.TypeOf((*MockRule)(nil).OfferDraw), b, player)
+}
+
 // OpenBattle mocks base method.
-func (m *MockRule) OpenBattle() *battle.Battle {
+func (m *MockRule) OpenBattle(rs battle.RuleSet) *battle.Battle {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "OpenBattle")
+	ret := m.ctrl.Call(m, "OpenBattle", rs)
 	ret0, _ := ret[0].(*battle.Battle)
 	return ret0
 }
 
 // OpenBattle indicates an expected call of OpenBattle.
-func (mr *MockRuleMockRecorder) OpenBattle() *gomock.Call {
+func (mr *MockRuleMockRecorder) OpenBattle(rs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenBattle", reflect.TypeOf((*MockRule)(nil).OpenBattle))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenBattle", reflect.TypeOf((*MockRule)(nil).OpenBattle), rs)
 }
 
 // Pick mocks base method.
@@ -91,6 +163,60 @@ func (mr *MockRuleMockRecorder) Pick(b, player, pos, size interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockRule)(nil).Pick), b, player, pos, size)
 }
 
+// PlayGobblet mocks base method.
+func (m *MockRule) PlayGobblet(b *battle.Battle, player tictactoe_battle.Player, a battle.GobbletAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlayGobblet", b, player, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlayGobblet indicates an expected call of PlayGobblet.
+func (mr *MockRuleMockRecorder) PlayGobblet(b, player, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayGobblet", reflect.TypeOf((*MockRule)(nil).PlayGobblet), b, player, a)
+}
+
+// Rematch mocks base method.
+func (m *MockRule) Rematch(b *battle.Battle, rm battle.Rematch) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Rematch", b, rm)
+}
+
+// Rematch indicates an expected call of Rematch.
+func (mr *MockRuleMockRecorder) Rematch(b, rm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rematch", reflect.TypeOf((*MockRule)(nil).Rematch), b, rm)
+}
+
+// RequestRematch mocks base method.
+func (m *MockRule) RequestRematch(b *battle.Battle, player tictactoe_battle.Player) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestRematch", b, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestRematch indicates an expected call of RequestRematch.
+func (mr *MockRuleMockRecorder) RequestRematch(b, player interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestRematch", reflect.TypeOf((*MockRule)(nil).RequestRematch), b, player)
+}
+
+// RequestReset mocks base method.
+func (m *MockRule) RequestReset(b *battle.Battle, player tictactoe_battle.Player) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestReset", b, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestReset indicates an expected call of RequestReset.
+func (mr *MockRuleMockRecorder) RequestReset(b, player interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReset", reflect.TypeOf((*MockRule)(nil).RequestReset), b, player)
+}
+
 // Reset mocks base method.
 func (m *MockRule) Reset(b *battle.Battle) {
 	m.ctrl.T.Helper()
@@ -102,3 +228,31 @@ func (mr *MockRuleMockRecorder) Reset(b interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockRule)(nil).Reset), b)
 }
+
+// Resign mocks base method.
+func (m *MockRule) Resign(b *battle.Battle, player tictactoe_battle.Player) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resign", b, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resign indicates an expected call of Resign.
+func (mr *MockRuleMockRecorder) Resign(b, player interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resign", reflect.TypeOf((*MockRule)(nil).Resign), b, player)
+}
+
+// Timeout mocks base method.
+func (m *MockRule) Timeout(b *battle.Battle, player tictactoe_battle.Player, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeout", b, player, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Timeout indicates an expected call of Timeout.
+func (mr *MockRuleMockRecorder) Timeout(b, player, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeout", reflect.TypeOf((*MockRule)(nil).Timeout), b, player, now)
+}
@@ -10,6 +10,7 @@ import (
 	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	gateways "github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
 )
 
 // MockMemDBClient is a mock of MemDBClient interface.
@@ -92,18 +93,48 @@ func (mr *MockMemDBClientMockRecorder) Ping(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockMemDBClient)(nil).Ping), ctx)
 }
 
-// PublishStream mocks base method.
-func (m *MockMemDBClient) PublishStream(ctx context.Context, streamKey string, messages map[string]interface{}) error {
+// Publish mocks base method.
+func (m *MockMemDBClient) Publish(ctx context.Context, channel string, message interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublishStream", ctx, streamKey, messages)
+	ret := m.ctrl.Call(m, "Publish", ctx, channel, message)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Publish indicates an expected call of Publish.
+func (mr *MockMemDBClientMockRecorder) Publish(ctx, channel, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockMemDBClient)(nil).Publish), ctx, channel, message)
+}
+
+// PublishStream mocks base method.
+func (m *MockMemDBClient) PublishStream(ctx context.Context, streamKey, previousID string, maxLen int64, boundaryKey string, messages map[string]interface{}) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishStream", ctx, streamKey, previousID, maxLen, boundaryKey, messages)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // PublishStream indicates an expected call of PublishStream.
-func (mr *MockMemDBClientMockRecorder) PublishStream(ctx, streamKey, messages interface{}) *gomock.Call {
+func (mr *MockMemDBClientMockRecorder) PublishStream(ctx, streamKey, previousID, maxLen, boundaryKey, messages interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishStream", reflect.TypeOf((*MockMemDBClient)(nil).PublishStream), ctx, streamKey, messages)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishStream", reflect.TypeOf((*MockMemDBClient)(nil).PublishStream), ctx, streamKey, previousID, maxLen, boundaryKey, messages)
+}
+
+// RangeStream mocks base method.
+func (m *MockMemDBClient) RangeStream(ctx context.Context, streamKey, messageKey string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeStream", ctx, streamKey, messageKey)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeStream indicates an expected call of RangeStream.
+func (mr *MockMemDBClientMockRecorder) RangeStream(ctx, streamKey, messageKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeStream", reflect.TypeOf((*MockMemDBClient)(nil).RangeStream), ctx, streamKey, messageKey)
 }
 
 // ReadStream mocks base method.
@@ -218,3 +249,67 @@ func (mr *MockMemDBClientMockRecorder) SetNX(ctx, key, value, duration interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockMemDBClient)(nil).SetNX), ctx, key, value, duration)
 }
+
+// Subscribe mocks base method.
+func (m *MockMemDBClient) Subscribe(ctx context.Context, channel string) (<-chan string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, channel)
+	ret0, _ := ret[0].(<-chan string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockMemDBClientMockRecorder) Subscribe(ctx, channel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockMemDBClient)(nil).Subscribe), ctx, channel)
+}
+
+// ZAdd mocks base method.
+func (m *MockMemDBClient) ZAdd(ctx context.Context, key string, members ...gateways.ZMember) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range members {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZAdd", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ZAdd indicates an expected call of ZAdd.
+func (mr *MockMemDBClientMockRecorder) ZAdd(ctx, key interface{}, members ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, members...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZAdd", reflect.TypeOf((*MockMemDBClient)(nil).ZAdd), varargs...)
+}
+
+// ZRevRangeWithScores mocks base method.
+func (m *MockMemDBClient) ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) ([]gateways.ZMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRevRangeWithScores", ctx, key, start, stop)
+	ret0, _ := ret[0].([]gateways.ZMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ZRevRangeWithScores indicates an expected call of ZRevRangeWithScores.
+func (mr *MockMemDBClientMockRecorder) ZRevRangeWithScores(ctx, key, start, stop interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeWithScores", reflect.TypeOf((*MockMemDBClient)(nil).ZRevRangeWithScores), ctx, key, start, stop)
+}
+
+// ZRevRank mocks base method.
+func (m *MockMemDBClient) ZRevRank(ctx context.Context, key, member string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRevRank", ctx, key, member)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ZRevRank indicates an expected call of ZRevRank.
+func (mr *MockMemDBClientMockRecorder) ZRevRank(ctx, key, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRank", reflect.TypeOf((*MockMemDBClient)(nil).ZRevRank), ctx, key, member)
+}
@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sql_db.go
+
+// Package mock_gateways is a generated GoMock package.
+package mock_gateways
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	gateways "github.com/swallowarc/tictactoe_battle_backend/internal/interface_adapters/gateways"
+)
+
+// MockSQLClient is a mock of SQLClient interface.
+type MockSQLClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSQLClientMockRecorder
+}
+
+// MockSQLClientMockRecorder is the mock recorder for MockSQLClient.
+type MockSQLClientMockRecorder struct {
+	mock *MockSQLClient
+}
+
+// NewMockSQLClient creates a new mock instance.
+func NewMockSQLClient(ctrl *gomock.Controller) *MockSQLClient {
+	mock := &MockSQLClient{ctrl: ctrl}
+	mock.recorder = &MockSQLClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSQLClient) EXPECT() *MockSQLClientMockRecorder {
+	return m.recorder
+}
+
+// Exec mocks base method.
+func (m *MockSQLClient) Exec(ctx context.Context, query string, args ...interface{}) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, query}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Exec", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockSQLClientMockRecorder) Exec(ctx, query interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, query}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockSQLClient)(nil).Exec), varargs...)
+}
+
+// Ping mocks base method.
+func (m *MockSQLClient) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockSQLClientMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockSQLClient)(nil).Ping), ctx)
+}
+
+// Query mocks base method.
+func (m *MockSQLClient) Query(ctx context.Context, query string, args ...interface{}) (gateways.SQLRows, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, query}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Query", varargs...)
+	ret0, _ := ret[0].(gateways.SQLRows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockSQLClientMockRecorder) Query(ctx, query interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, query}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockSQLClient)(nil).Query), varargs...)
+}
+
+// Transaction mocks base method.
+func (m *MockSQLClient) Transaction(ctx context.Context, fn func(gateways.SQLClient) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockSQLClientMockRecorder) Transaction(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockSQLClient)(nil).Transaction), ctx, fn)
+}
+
+// MockSQLRows is a mock of SQLRows interface.
+type MockSQLRows struct {
+	ctrl     *gomock.Controller
+	recorder *MockSQLRowsMockRecorder
+}
+
+// MockSQLRowsMockRecorder is the mock recorder for MockSQLRows.
+type MockSQLRowsMockRecorder struct {
+	mock *MockSQLRows
+}
+
+// NewMockSQLRows creates a new mock instance.
+func NewMockSQLRows(ctrl *gomock.Controller) *MockSQLRows {
+	mock := &MockSQLRows{ctrl: ctrl}
+	mock.recorder = &MockSQLRowsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSQLRows) EXPECT() *MockSQLRowsMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSQLRows) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSQLRowsMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSQLRows)(nil).Close))
+}
+
+// Err mocks base method.
+func (m *MockSQLRows) Err() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Err")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Err indicates an expected call of Err.
+func (mr *MockSQLRowsMockRecorder) Err() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Err", reflect.TypeOf((*MockSQLRows)(nil).Err))
+}
+
+// Next mocks base method.
+func (m *MockSQLRows) Next() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockSQLRowsMockRecorder) Next() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockSQLRows)(nil).Next))
+}
+
+// Scan mocks base method.
+func (m *MockSQLRows) Scan(dest ...interface{}) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockSQLRowsMockRecorder) Scan(dest ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockSQLRows)(nil).Scan), dest...)
+}
@@ -10,8 +10,15 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	tictactoe_battle "github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	archive "github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
+	battle "github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	bot "github.com/swallowarc/tictactoe_battle_backend/internal/domains/bot"
+	leaderboard "github.com/swallowarc/tictactoe_battle_backend/internal/domains/leaderboard"
+	rating "github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
 	room "github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	interactors "github.com/swallowarc/tictactoe_battle_backend/internal/usecases/interactors"
 	ports "github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	tictactoe_battle_ext "github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
 )
 
 // MockLoginInteractor is a mock of LoginInteractor interface.
@@ -37,6 +44,20 @@ func (m *MockLoginInteractor) EXPECT() *MockLoginInteractorMockRecorder {
 	return m.recorder
 }
 
+// Authenticate mocks base method.
+func (m *MockLoginInteractor) Authenticate(ctx context.Context, login *tictactoe_battle.Login) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockLoginInteractorMockRecorder) Authenticate(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockLoginInteractor)(nil).Authenticate), ctx, login)
+}
+
 // Login mocks base method.
 func (m *MockLoginInteractor) Login(ctx context.Context, login *tictactoe_battle.Login) (*tictactoe_battle.Login, error) {
 	m.ctrl.T.Helper()
@@ -89,18 +110,46 @@ func (m *MockBattleInteractor) EXPECT() *MockBattleInteractorMockRecorder {
 	return m.recorder
 }
 
+// AcceptDraw mocks base method.
+func (m *MockBattleInteractor) AcceptDraw(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptDraw", ctx, roomID, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptDraw indicates an expected call of AcceptDraw.
+func (mr *MockBattleInteractorMockRecorder) AcceptDraw(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptDraw", reflect.TypeOf((*MockBattleInteractor)(nil).AcceptDraw), ctx, roomID, login)
+}
+
+// AcceptRematch mocks base method.
+func (m *MockBattleInteractor) AcceptRematch(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptRematch", ctx, roomID, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptRematch indicates an expected call of AcceptRematch.
+func (mr *MockBattleInteractorMockRecorder) AcceptRematch(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptRematch", reflect.TypeOf((*MockBattleInteractor)(nil).AcceptRematch), ctx, roomID, login)
+}
+
 // Attack mocks base method.
-func (m *MockBattleInteractor) Attack(ctx context.Context, roomID room.ID, player tictactoe_battle.Player, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error {
+func (m *MockBattleInteractor) Attack(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Attack", ctx, roomID, player, position, pieceSize)
+	ret := m.ctrl.Call(m, "Attack", ctx, roomID, login, position, pieceSize)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Attack indicates an expected call of Attack.
-func (mr *MockBattleInteractorMockRecorder) Attack(ctx, roomID, player, position, pieceSize interface{}) *gomock.Call {
+func (mr *MockBattleInteractorMockRecorder) Attack(ctx, roomID, login, position, pieceSize interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attack", reflect.TypeOf((*MockBattleInteractor)(nil).Attack), ctx, roomID, player, position, pieceSize)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attack", reflect.TypeOf((*MockBattleInteractor)(nil).Attack), ctx, roomID, login, position, pieceSize)
 }
 
 // CanEnter mocks base method.
@@ -119,18 +168,23 @@ func (mr *MockBattleInteractorMockRecorder) CanEnter(ctx, roomID, loginID interf
 }
 
 // Create mocks base method.
-func (m *MockBattleInteractor) Create(ctx context.Context) (room.ID, error) {
+func (m *MockBattleInteractor) Create(ctx context.Context, opts ...interactors.CreateOption) (room.ID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx)
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
 	ret0, _ := ret[0].(room.ID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockBattleInteractorMockRecorder) Create(ctx interface{}) *gomock.Call {
+func (mr *MockBattleInteractorMockRecorder) Create(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBattleInteractor)(nil).Create), ctx)
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBattleInteractor)(nil).Create), varargs...)
 }
 
 // Declaration mocks base method.
@@ -147,6 +201,20 @@ func (mr *MockBattleInteractorMockRecorder) Declaration(ctx, roomID, loginID int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Declaration", reflect.TypeOf((*MockBattleInteractor)(nil).Declaration), ctx, roomID, loginID)
 }
 
+// DeclineDraw mocks base method.
+func (m *MockBattleInteractor) DeclineDraw(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineDraw", ctx, roomID, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineDraw indicates an expected call of DeclineDraw.
+func (mr *MockBattleInteractorMockRecorder) DeclineDraw(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineDraw", reflect.TypeOf((*MockBattleInteractor)(nil).DeclineDraw), ctx, roomID, login)
+}
+
 // Enter mocks base method.
 func (m *MockBattleInteractor) Enter(ctx context.Context, roomID room.ID, loginID string) (ports.BattleListener, error) {
 	m.ctrl.T.Helper()
@@ -162,6 +230,21 @@ func (mr *MockBattleInteractorMockRecorder) Enter(ctx, roomID, loginID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enter", reflect.TypeOf((*MockBattleInteractor)(nil).Enter), ctx, roomID, loginID)
 }
 
+// GobbletBoard mocks base method.
+func (m *MockBattleInteractor) GobbletBoard(ctx context.Context, roomID room.ID) (*battle.GobbletBoard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GobbletBoard", ctx, roomID)
+	ret0, _ := ret[0].(*battle.GobbletBoard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GobbletBoard indicates an expected call of GobbletBoard.
+func (mr *MockBattleInteractorMockRecorder) GobbletBoard(ctx, roomID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GobbletBoard", reflect.TypeOf((*MockBattleInteractor)(nil).GobbletBoard), ctx, roomID)
+}
+
 // Leave mocks base method.
 func (m *MockBattleInteractor) Leave(ctx context.Context, roomID room.ID, loginID string) error {
 	m.ctrl.T.Helper()
@@ -176,30 +259,348 @@ func (mr *MockBattleInteractorMockRecorder) Leave(ctx, roomID, loginID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leave", reflect.TypeOf((*MockBattleInteractor)(nil).Leave), ctx, roomID, loginID)
 }
 
+// LegalMoves mocks base method.
+func (m *MockBattleInteractor) LegalMoves(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) ([]battle.Action, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LegalMoves", ctx, roomID, login)
+	ret0, _ := ret[0].([]battle.Action)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LegalMoves indicates an expected call of LegalMoves.
+func (mr *MockBattleInteractorMockRecorder) LegalMoves(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LegalMoves", reflect.TypeOf((*MockBattleInteractor)(nil).LegalMoves), ctx, roomID, login)
+}
+
+// OfferDraw mocks base method.
+func (m *MockBattleInteractor) OfferDraw(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferDraw", ctx, roomID, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OfferDraw indicates an expected call of OfferDraw.
+func (mr *MockBattleInteractorMockRecorder) OfferDraw(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferDraw", reflect.TypeOf((*MockBattleInteractor)(nil).OfferDraw), ctx, roomID, login)
+}
+
 // Pick mocks base method.
-func (m *MockBattleInteractor) Pick(ctx context.Context, roomID room.ID, player tictactoe_battle.Player, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error {
+func (m *MockBattleInteractor) Pick(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Pick", ctx, roomID, player, position, pieceSize)
+	ret := m.ctrl.Call(m, "Pick", ctx, roomID, login, position, pieceSize)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Pick indicates an expected call of Pick.
-func (mr *MockBattleInteractorMockRecorder) Pick(ctx, roomID, player, position, pieceSize interface{}) *gomock.Call {
+func (mr *MockBattleInteractorMockRecorder) Pick(ctx, roomID, login, position, pieceSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockBattleInteractor)(nil).Pick), ctx, roomID, login, position, pieceSize)
+}
+
+// PlayGobblet mocks base method.
+func (m *MockBattleInteractor) PlayGobblet(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, a battle.GobbletAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlayGobblet", ctx, roomID, login, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlayGobblet indicates an expected call of PlayGobblet.
+func (mr *MockBattleInteractorMockRecorder) PlayGobblet(ctx, roomID, login, a interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockBattleInteractor)(nil).Pick), ctx, roomID, player, position, pieceSize)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayGobblet", reflect.TypeOf((*MockBattleInteractor)(nil).PlayGobblet), ctx, roomID, login, a)
+}
+
+// ReconcileRatings mocks base method.
+func (m *MockBattleInteractor) ReconcileRatings(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileRatings", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileRatings indicates an expected call of ReconcileRatings.
+func (mr *MockBattleInteractorMockRecorder) ReconcileRatings(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileRatings", reflect.TypeOf((*MockBattleInteractor)(nil).ReconcileRatings), ctx)
+}
+
+// RequestRematch mocks base method.
+func (m *MockBattleInteractor) RequestRematch(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestRematch", ctx, roomID, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestRematch indicates an expected call of RequestRematch.
+func (mr *MockBattleInteractorMockRecorder) RequestRematch(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestRematch", reflect.TypeOf((*MockBattleInteractor)(nil).RequestRematch), ctx, roomID, login)
 }
 
 // Reset mocks base method.
-func (m *MockBattleInteractor) Reset(ctx context.Context, roomID room.ID) error {
+func (m *MockBattleInteractor) Reset(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Reset", ctx, roomID)
+	ret := m.ctrl.Call(m, "Reset", ctx, roomID, login)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Reset indicates an expected call of Reset.
-func (mr *MockBattleInteractorMockRecorder) Reset(ctx, roomID interface{}) *gomock.Call {
+func (mr *MockBattleInteractorMockRecorder) Reset(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockBattleInteractor)(nil).Reset), ctx, roomID, login)
+}
+
+// Resign mocks base method.
+func (m *MockBattleInteractor) Resign(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resign", ctx, roomID, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resign indicates an expected call of Resign.
+func (mr *MockBattleInteractorMockRecorder) Resign(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resign", reflect.TypeOf((*MockBattleInteractor)(nil).Resign), ctx, roomID, login)
+}
+
+// SuggestMove mocks base method.
+func (m *MockBattleInteractor) SuggestMove(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) (*bot.Analysis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestMove", ctx, roomID, login)
+	ret0, _ := ret[0].(*bot.Analysis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestMove indicates an expected call of SuggestMove.
+func (mr *MockBattleInteractorMockRecorder) SuggestMove(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestMove", reflect.TypeOf((*MockBattleInteractor)(nil).SuggestMove), ctx, roomID, login)
+}
+
+// Timeout mocks base method.
+func (m *MockBattleInteractor) Timeout(ctx context.Context, roomID room.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeout", ctx, roomID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Timeout indicates an expected call of Timeout.
+func (mr *MockBattleInteractorMockRecorder) Timeout(ctx, roomID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeout", reflect.TypeOf((*MockBattleInteractor)(nil).Timeout), ctx, roomID)
+}
+
+// MockReplayInteractor is a mock of ReplayInteractor interface.
+type MockReplayInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockReplayInteractorMockRecorder
+}
+
+// MockReplayInteractorMockRecorder is the mock recorder for MockReplayInteractor.
+type MockReplayInteractorMockRecorder struct {
+	mock *MockReplayInteractor
+}
+
+// NewMockReplayInteractor creates a new mock instance.
+func NewMockReplayInteractor(ctrl *gomock.Controller) *MockReplayInteractor {
+	mock := &MockReplayInteractor{ctrl: ctrl}
+	mock.recorder = &MockReplayInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReplayInteractor) EXPECT() *MockReplayInteractorMockRecorder {
+	return m.recorder
+}
+
+// GetReplay mocks base method.
+func (m *MockReplayInteractor) GetReplay(ctx context.Context, gameID battle.GameID, loginID string) ([]*tictactoe_battle_ext.BattleSituation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplay", ctx, gameID, loginID)
+	ret0, _ := ret[0].([]*tictactoe_battle_ext.BattleSituation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplay indicates an expected call of GetReplay.
+func (mr *MockReplayInteractorMockRecorder) GetReplay(ctx, gameID, loginID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplay", reflect.TypeOf((*MockReplayInteractor)(nil).GetReplay), ctx, gameID, loginID)
+}
+
+// StreamReplay mocks base method.
+func (m *MockReplayInteractor) StreamReplay(ctx context.Context, gameID battle.GameID, loginID string, speed float64) (ports.BattleListener, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamReplay", ctx, gameID, loginID, speed)
+	ret0, _ := ret[0].(ports.BattleListener)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamReplay indicates an expected call of StreamReplay.
+func (mr *MockReplayInteractorMockRecorder) StreamReplay(ctx, gameID, loginID, speed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamReplay", reflect.TypeOf((*MockReplayInteractor)(nil).StreamReplay), ctx, gameID, loginID, speed)
+}
+
+// MockPlayerInteractor is a mock of PlayerInteractor interface.
+type MockPlayerInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlayerInteractorMockRecorder
+}
+
+// MockPlayerInteractorMockRecorder is the mock recorder for MockPlayerInteractor.
+type MockPlayerInteractorMockRecorder struct {
+	mock *MockPlayerInteractor
+}
+
+// NewMockPlayerInteractor creates a new mock instance.
+func NewMockPlayerInteractor(ctrl *gomock.Controller) *MockPlayerInteractor {
+	mock := &MockPlayerInteractor{ctrl: ctrl}
+	mock.recorder = &MockPlayerInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlayerInteractor) EXPECT() *MockPlayerInteractorMockRecorder {
+	return m.recorder
+}
+
+// GetPlayerProfile mocks base method.
+func (m *MockPlayerInteractor) GetPlayerProfile(ctx context.Context, loginID string) (*archive.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayerProfile", ctx, loginID)
+	ret0, _ := ret[0].(*archive.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayerProfile indicates an expected call of GetPlayerProfile.
+func (mr *MockPlayerInteractorMockRecorder) GetPlayerProfile(ctx, loginID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerProfile", reflect.TypeOf((*MockPlayerInteractor)(nil).GetPlayerProfile), ctx, loginID)
+}
+
+// GetRating mocks base method.
+func (m *MockPlayerInteractor) GetRating(ctx context.Context, loginID string) (*rating.Rating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRating", ctx, loginID)
+	ret0, _ := ret[0].(*rating.Rating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRating indicates an expected call of GetRating.
+func (mr *MockPlayerInteractorMockRecorder) GetRating(ctx, loginID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRating", reflect.TypeOf((*MockPlayerInteractor)(nil).GetRating), ctx, loginID)
+}
+
+// ListMatchHistory mocks base method.
+func (m *MockPlayerInteractor) ListMatchHistory(ctx context.Context, loginID, pageToken string, pageSize int) (*archive.MatchHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMatchHistory", ctx, loginID, pageToken, pageSize)
+	ret0, _ := ret[0].(*archive.MatchHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMatchHistory indicates an expected call of ListMatchHistory.
+func (mr *MockPlayerInteractorMockRecorder) ListMatchHistory(ctx, loginID, pageToken, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMatchHistory", reflect.TypeOf((*MockPlayerInteractor)(nil).ListMatchHistory), ctx, loginID, pageToken, pageSize)
+}
+
+// MockLeaderboardInteractor is a mock of LeaderboardInteractor interface.
+type MockLeaderboardInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaderboardInteractorMockRecorder
+}
+
+// MockLeaderboardInteractorMockRecorder is the mock recorder for MockLeaderboardInteractor.
+type MockLeaderboardInteractorMockRecorder struct {
+	mock *MockLeaderboardInteractor
+}
+
+// NewMockLeaderboardInteractor creates a new mock instance.
+func NewMockLeaderboardInteractor(ctrl *gomock.Controller) *MockLeaderboardInteractor {
+	mock := &MockLeaderboardInteractor{ctrl: ctrl}
+	mock.recorder = &MockLeaderboardInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaderboardInteractor) EXPECT() *MockLeaderboardInteractorMockRecorder {
+	return m.recorder
+}
+
+// CloseSeason mocks base method.
+func (m *MockLeaderboardInteractor) CloseSeason(ctx context.Context) (*leaderboard.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSeason", ctx)
+	ret0, _ := ret[0].(*leaderboard.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseSeason indicates an expected call of CloseSeason.
+func (mr *MockLeaderboardInteractorMockRecorder) CloseSeason(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSeason", reflect.TypeOf((*MockLeaderboardInteractor)(nil).CloseSeason), ctx)
+}
+
+// GetLeaderboard mocks base method.
+func (m *MockLeaderboardInteractor) GetLeaderboard(ctx context.Context, board leaderboard.Board, pageToken string, pageSize int) (*leaderboard.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboard", ctx, board, pageToken, pageSize)
+	ret0, _ := ret[0].(*leaderboard.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboard indicates an expected call of GetLeaderboard.
+func (mr *MockLeaderboardInteractorMockRecorder) GetLeaderboard(ctx, board, pageToken, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboard", reflect.TypeOf((*MockLeaderboardInteractor)(nil).GetLeaderboard), ctx, board, pageToken, pageSize)
+}
+
+// GetMyRank mocks base method.
+func (m *MockLeaderboardInteractor) GetMyRank(ctx context.Context, board leaderboard.Board, loginID string) (*leaderboard.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyRank", ctx, board, loginID)
+	ret0, _ := ret[0].(*leaderboard.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyRank indicates an expected call of GetMyRank.
+func (mr *MockLeaderboardInteractorMockRecorder) GetMyRank(ctx, board, loginID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyRank", reflect.TypeOf((*MockLeaderboardInteractor)(nil).GetMyRank), ctx, board, loginID)
+}
+
+// GetSeason mocks base method.
+func (m *MockLeaderboardInteractor) GetSeason(ctx context.Context, number int) (*leaderboard.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeason", ctx, number)
+	ret0, _ := ret[0].(*leaderboard.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeason indicates an expected call of GetSeason.
+func (mr *MockLeaderboardInteractorMockRecorder) GetSeason(ctx, number interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockBattleInteractor)(nil).Reset), ctx, roomID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeason", reflect.TypeOf((*MockLeaderboardInteractor)(nil).GetSeason), ctx, number)
 }
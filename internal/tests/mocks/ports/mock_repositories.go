@@ -7,10 +7,14 @@ package mock_ports
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	tictactoe_battle "github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	archive "github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
 	battle "github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	leaderboard "github.com/swallowarc/tictactoe_battle_backend/internal/domains/leaderboard"
+	rating "github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
 	room "github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
 )
 
@@ -205,20 +209,19 @@ func (mr *MockBattleRepositoryMockRecorder) ListMembers(ctx, roomID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockBattleRepository)(nil).ListMembers), ctx, roomID)
 }
 
-// ReadStream mocks base method.
-func (m *MockBattleRepository) ReadStream(ctx context.Context, roomID room.ID, messageID string) (string, *battle.Battle, error) {
+// ListMoves mocks base method.
+func (m *MockBattleRepository) ListMoves(ctx context.Context, roomID room.ID) ([]*battle.Move, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadStream", ctx, roomID, messageID)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(*battle.Battle)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListMoves", ctx, roomID)
+	ret0, _ := ret[0].([]*battle.Move)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ReadStream indicates an expected call of ReadStream.
-func (mr *MockBattleRepositoryMockRecorder) ReadStream(ctx, roomID, messageID interface{}) *gomock.Call {
+// ListMoves indicates an expected call of ListMoves.
+func (mr *MockBattleRepositoryMockRecorder) ListMoves(ctx, roomID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStream", reflect.TypeOf((*MockBattleRepository)(nil).ReadStream), ctx, roomID, messageID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMoves", reflect.TypeOf((*MockBattleRepository)(nil).ListMoves), ctx, roomID)
 }
 
 // ReadStreamLatest mocks base method.
@@ -237,16 +240,482 @@ func (mr *MockBattleRepositoryMockRecorder) ReadStreamLatest(ctx, roomID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStreamLatest", reflect.TypeOf((*MockBattleRepository)(nil).ReadStreamLatest), ctx, roomID)
 }
 
+// Subscribe mocks base method.
+func (m *MockBattleRepository) Subscribe(ctx context.Context, roomID room.ID) (<-chan room.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, roomID)
+	ret0, _ := ret[0].(<-chan room.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockBattleRepositoryMockRecorder) Subscribe(ctx, roomID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockBattleRepository)(nil).Subscribe), ctx, roomID)
+}
+
 // Update mocks base method.
-func (m *MockBattleRepository) Update(ctx context.Context, battle *battle.Battle) error {
+func (m *MockBattleRepository) Update(ctx context.Context, previousID string, battle *battle.Battle, move *battle.Move) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, battle)
+	ret := m.ctrl.Call(m, "Update", ctx, previousID, battle, move)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockBattleRepositoryMockRecorder) Update(ctx, battle interface{}) *gomock.Call {
+func (mr *MockBattleRepositoryMockRecorder) Update(ctx, previousID, battle, move interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBattleRepository)(nil).Update), ctx, previousID, battle, move)
+}
+
+// MockRateLimitRepository is a mock of RateLimitRepository interface.
+type MockRateLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitRepositoryMockRecorder
+}
+
+// MockRateLimitRepositoryMockRecorder is the mock recorder for MockRateLimitRepository.
+type MockRateLimitRepositoryMockRecorder struct {
+	mock *MockRateLimitRepository
+}
+
+// NewMockRateLimitRepository creates a new mock instance.
+func NewMockRateLimitRepository(ctrl *gomock.Controller) *MockRateLimitRepository {
+	mock := &MockRateLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockRateLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitRepository) EXPECT() *MockRateLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockRateLimitRepository) Allow(ctx context.Context, key string, interval time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, key, interval)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimitRepositoryMockRecorder) Allow(ctx, key, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimitRepository)(nil).Allow), ctx, key, interval)
+}
+
+// MockReplayRepository is a mock of ReplayRepository interface.
+type MockReplayRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReplayRepositoryMockRecorder
+}
+
+// MockReplayRepositoryMockRecorder is the mock recorder for MockReplayRepository.
+type MockReplayRepositoryMockRecorder struct {
+	mock *MockReplayRepository
+}
+
+// NewMockReplayRepository creates a new mock instance.
+func NewMockReplayRepository(ctrl *gomock.Controller) *MockReplayRepository {
+	mock := &MockReplayRepository{ctrl: ctrl}
+	mock.recorder = &MockReplayRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReplayRepository) EXPECT() *MockReplayRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindMoves mocks base method.
+func (m *MockReplayRepository) FindMoves(ctx context.Context, gameID battle.GameID) ([]*battle.Move, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMoves", ctx, gameID)
+	ret0, _ := ret[0].([]*battle.Move)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMoves indicates an expected call of FindMoves.
+func (mr *MockReplayRepositoryMockRecorder) FindMoves(ctx, gameID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMoves", reflect.TypeOf((*MockReplayRepository)(nil).FindMoves), ctx, gameID)
+}
+
+// Save mocks base method.
+func (m *MockReplayRepository) Save(ctx context.Context, gameID battle.GameID, moves []*battle.Move) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, gameID, moves)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockReplayRepositoryMockRecorder) Save(ctx, gameID, moves interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockReplayRepository)(nil).Save), ctx, gameID, moves)
+}
+
+// MockGameArchiveRepository is a mock of GameArchiveRepository interface.
+type MockGameArchiveRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGameArchiveRepositoryMockRecorder
+}
+
+// MockGameArchiveRepositoryMockRecorder is the mock recorder for MockGameArchiveRepository.
+type MockGameArchiveRepositoryMockRecorder struct {
+	mock *MockGameArchiveRepository
+}
+
+// NewMockGameArchiveRepository creates a new mock instance.
+func NewMockGameArchiveRepository(ctrl *gomock.Controller) *MockGameArchiveRepository {
+	mock := &MockGameArchiveRepository{ctrl: ctrl}
+	mock.recorder = &MockGameArchiveRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGameArchiveRepository) EXPECT() *MockGameArchiveRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountOutcomes mocks base method.
+func (m *MockGameArchiveRepository) CountOutcomes(ctx context.Context, playerID string) (map[archive.Outcome]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOutcomes", ctx, playerID)
+	ret0, _ := ret[0].(map[archive.Outcome]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOutcomes indicates an expected call of CountOutcomes.
+func (mr *MockGameArchiveRepositoryMockRecorder) CountOutcomes(ctx, playerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOutcomes", reflect.TypeOf((*MockGameArchiveRepository)(nil).CountOutcomes), ctx, playerID)
+}
+
+// FavoriteOpening mocks base method.
+func (m *MockGameArchiveRepository) FavoriteOpening(ctx context.Context, playerID string) (tictactoe_battle.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FavoriteOpening", ctx, playerID)
+	ret0, _ := ret[0].(tictactoe_battle.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FavoriteOpening indicates an expected call of FavoriteOpening.
+func (mr *MockGameArchiveRepositoryMockRecorder) FavoriteOpening(ctx, playerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteOpening", reflect.TypeOf((*MockGameArchiveRepository)(nil).FavoriteOpening), ctx, playerID)
+}
+
+// Find mocks base method.
+func (m *MockGameArchiveRepository) Find(ctx context.Context, gameID battle.GameID) (*archive.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, gameID)
+	ret0, _ := ret[0].(*archive.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockGameArchiveRepositoryMockRecorder) Find(ctx, gameID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockGameArchiveRepository)(nil).Find), ctx, gameID)
+}
+
+// ListByPlayer mocks base method.
+func (m *MockGameArchiveRepository) ListByPlayer(ctx context.Context, playerID string, offset, limit int) ([]*archive.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPlayer", ctx, playerID, offset, limit)
+	ret0, _ := ret[0].([]*archive.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPlayer indicates an expected call of ListByPlayer.
+func (mr *MockGameArchiveRepositoryMockRecorder) ListByPlayer(ctx, playerID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPlayer", reflect.TypeOf((*MockGameArchiveRepository)(nil).ListByPlayer), ctx, playerID, offset, limit)
+}
+
+// ListOutcomes mocks base method.
+func (m *MockGameArchiveRepository) ListOutcomes(ctx context.Context, playerID string, offset, limit int) ([]archive.Outcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutcomes", ctx, playerID, offset, limit)
+	ret0, _ := ret[0].([]archive.Outcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutcomes indicates an expected call of ListOutcomes.
+func (mr *MockGameArchiveRepositoryMockRecorder) ListOutcomes(ctx, playerID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutcomes", reflect.TypeOf((*MockGameArchiveRepository)(nil).ListOutcomes), ctx, playerID, offset, limit)
+}
+
+// ListUnrated mocks base method.
+func (m *MockGameArchiveRepository) ListUnrated(ctx context.Context, limit int) ([]*archive.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnrated", ctx, limit)
+	ret0, _ := ret[0].([]*archive.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnrated indicates an expected call of ListUnrated.
+func (mr *MockGameArchiveRepositoryMockRecorder) ListUnrated(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnrated", reflect.TypeOf((*MockGameArchiveRepository)(nil).ListUnrated), ctx, limit)
+}
+
+// Save mocks base method.
+func (m *MockGameArchiveRepository) Save(ctx context.Context, game *archive.Game) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, game)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockGameArchiveRepositoryMockRecorder) Save(ctx, game interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockGameArchiveRepository)(nil).Save), ctx, game)
+}
+
+// MockRatingRepository is a mock of RatingRepository interface.
+type MockRatingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRatingRepositoryMockRecorder
+}
+
+// MockRatingRepositoryMockRecorder is the mock recorder for MockRatingRepository.
+type MockRatingRepositoryMockRecorder struct {
+	mock *MockRatingRepository
+}
+
+// NewMockRatingRepository creates a new mock instance.
+func NewMockRatingRepository(ctrl *gomock.Controller) *MockRatingRepository {
+	mock := &MockRatingRepository{ctrl: ctrl}
+	mock.recorder = &MockRatingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRatingRepository) EXPECT() *MockRatingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockRatingRepository) Find(ctx context.Context, playerID string) (*rating.Rating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, playerID)
+	ret0, _ := ret[0].(*rating.Rating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockRatingRepositoryMockRecorder) Find(ctx, playerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockRatingRepository)(nil).Find), ctx, playerID)
+}
+
+// FindResult mocks base method.
+func (m *MockRatingRepository) FindResult(ctx context.Context, gameID battle.GameID) (*rating.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindResult", ctx, gameID)
+	ret0, _ := ret[0].(*rating.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindResult indicates an expected call of FindResult.
+func (mr *MockRatingRepositoryMockRecorder) FindResult(ctx, gameID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindResult", reflect.TypeOf((*MockRatingRepository)(nil).FindResult), ctx, gameID)
+}
+
+// Save mocks base method.
+func (m *MockRatingRepository) Save(ctx context.Context, gameID battle.GameID, result *rating.Result, ratings ...*rating.Rating) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, gameID, result}
+	for _, a := range ratings {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Save", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockRatingRepositoryMockRecorder) Save(ctx, gameID, result interface{}, ratings ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, gameID, result}, ratings...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRatingRepository)(nil).Save), varargs...)
+}
+
+// MockLeaderboardRepository is a mock of LeaderboardRepository interface.
+type MockLeaderboardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaderboardRepositoryMockRecorder
+}
+
+// MockLeaderboardRepositoryMockRecorder is the mock recorder for MockLeaderboardRepository.
+type MockLeaderboardRepositoryMockRecorder struct {
+	mock *MockLeaderboardRepository
+}
+
+// NewMockLeaderboardRepository creates a new mock instance.
+func NewMockLeaderboardRepository(ctrl *gomock.Controller) *MockLeaderboardRepository {
+	mock := &MockLeaderboardRepository{ctrl: ctrl}
+	mock.recorder = &MockLeaderboardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaderboardRepository) EXPECT() *MockLeaderboardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockLeaderboardRepository) Clear(ctx context.Context, season int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx, season)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockLeaderboardRepositoryMockRecorder) Clear(ctx, season interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockLeaderboardRepository)(nil).Clear), ctx, season)
+}
+
+// List mocks base method.
+func (m *MockLeaderboardRepository) List(ctx context.Context, season int, board leaderboard.Board, at time.Time, offset, limit int) ([]leaderboard.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, season, board, at, offset, limit)
+	ret0, _ := ret[0].([]leaderboard.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLeaderboardRepositoryMockRecorder) List(ctx, season, board, at, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLeaderboardRepository)(nil).List), ctx, season, board, at, offset, limit)
+}
+
+// Rank mocks base method.
+func (m *MockLeaderboardRepository) Rank(ctx context.Context, season int, board leaderboard.Board, at time.Time, playerID string) (*leaderboard.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rank", ctx, season, board, at, playerID)
+	ret0, _ := ret[0].(*leaderboard.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rank indicates an expected call of Rank.
+func (mr *MockLeaderboardRepositoryMockRecorder) Rank(ctx, season, board, at, playerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rank", reflect.TypeOf((*MockLeaderboardRepository)(nil).Rank), ctx, season, board, at, playerID)
+}
+
+// Record mocks base method.
+func (m *MockLeaderboardRepository) Record(ctx context.Context, season int, playerID string, rating float64, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, season, playerID, rating, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockLeaderboardRepositoryMockRecorder) Record(ctx, season, playerID, rating, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockLeaderboardRepository)(nil).Record), ctx, season, playerID, rating, at)
+}
+
+// MockSeasonRepository is a mock of SeasonRepository interface.
+type MockSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeasonRepositoryMockRecorder
+}
+
+// MockSeasonRepositoryMockRecorder is the mock recorder for MockSeasonRepository.
+type MockSeasonRepositoryMockRecorder struct {
+	mock *MockSeasonRepository
+}
+
+// NewMockSeasonRepository creates a new mock instance.
+func NewMockSeasonRepository(ctrl *gomock.Controller) *MockSeasonRepository {
+	mock := &MockSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeasonRepository) EXPECT() *MockSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSeasonRepository) Close(ctx context.Context, number int, closedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx, number, closedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSeasonRepositoryMockRecorder) Close(ctx, number, closedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSeasonRepository)(nil).Close), ctx, number, closedAt)
+}
+
+// Current mocks base method.
+func (m *MockSeasonRepository) Current(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockSeasonRepositoryMockRecorder) Current(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockSeasonRepository)(nil).Current), ctx)
+}
+
+// Find mocks base method.
+func (m *MockSeasonRepository) Find(ctx context.Context, number int) (*leaderboard.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, number)
+	ret0, _ := ret[0].(*leaderboard.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSeasonRepositoryMockRecorder) Find(ctx, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSeasonRepository)(nil).Find), ctx, number)
+}
+
+// SaveStandings mocks base method.
+func (m *MockSeasonRepository) SaveStandings(ctx context.Context, number int, standings []leaderboard.Entry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveStandings", ctx, number, standings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveStandings indicates an expected call of SaveStandings.
+func (mr *MockSeasonRepositoryMockRecorder) SaveStandings(ctx, number, standings interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBattleRepository)(nil).Update), ctx, battle)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStandings", reflect.TypeOf((*MockSeasonRepository)(nil).SaveStandings), ctx, number, standings)
 }
@@ -2,37 +2,102 @@ package interactors
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/bot"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/tablebase"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/listener"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
 	"golang.org/x/xerrors"
 )
 
+const (
+	// maxUpdateAttempts battle更新が競合した場合の最大試行回数.
+	maxUpdateAttempts = 3
+	// suggestInterval 同じゲームで同じプレイヤーがヒントを求められる間隔.
+	suggestInterval = 30 * time.Second
+)
+
 type (
 	battleInteractor struct {
-		battleRule battle.Rule
-		battleRepo ports.BattleRepository
+		battleRule  battle.Rule
+		tablebase   *tablebase.Tablebase
+		battleRepo  ports.BattleRepository
+		loginRepo   ports.LoginRepository
+		replayRepo  ports.ReplayRepository
+		limitRepo   ports.RateLimitRepository
+		archiveRepo ports.GameArchiveRepository
+		ratingRepo  ports.RatingRepository
+		boardRepo   ports.LeaderboardRepository
+		seasonRepo  ports.SeasonRepository
+		hub         listener.Hub
+
+		// settling 保存中の終了したゲーム.
+		settling sync.WaitGroup
+		// settleBackoff 終了したゲームの保存に失敗した場合の最初の再試行までの間隔.
+		settleBackoff time.Duration
 	}
 )
 
 func NewBattleInteractor(dFactory domains.Factory, rFactory ports.RepositoriesFactory) BattleInteractor {
 	return &battleInteractor{
-		battleRule: dFactory.BattleRule(),
-		battleRepo: rFactory.BattleRepository(),
+		battleRule:  dFactory.BattleRule(),
+		tablebase:   dFactory.Tablebase(),
+		battleRepo:  rFactory.BattleRepository(),
+		loginRepo:   rFactory.LoginRepository(),
+		replayRepo:  rFactory.ReplayRepository(),
+		limitRepo:   rFactory.RateLimitRepository(),
+		archiveRepo: rFactory.GameArchiveRepository(),
+		ratingRepo:  rFactory.RatingRepository(),
+		boardRepo:   rFactory.LeaderboardRepository(),
+		seasonRepo:  rFactory.SeasonRepository(),
+		hub:         listener.NewHub(rFactory.BattleRepository()),
+
+		settleBackoff: defaultSettleBackoff,
 	}
 }
 
-func (bi *battleInteractor) Create(ctx context.Context) (room.ID, error) {
-	roomID, err := bi.battleRepo.Create(ctx, bi.battleRule.OpenBattle())
+func (bi *battleInteractor) Create(ctx context.Context, opts ...CreateOption) (room.ID, error) {
+	o := &createOptions{ruleSet: battle.DefaultRuleSet()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if err := o.ruleSet.Validate(); err != nil {
+		return "", err
+	}
+	if err := o.timeControl.Validate(); err != nil {
+		return "", err
+	}
+
+	if o.ranked && o.botLevel != 0 {
+		return "", exceptions.NewInvalidArgumentError("ranked room cannot have a bot")
+	}
+	if o.ruleSet.IsGobblet() && o.botLevel != 0 {
+		return "", exceptions.NewInvalidArgumentError("bot does not support gobblet mode")
+	}
+
+	b := bi.battleRule.OpenBattle(o.ruleSet)
+	b.Ranked = o.ranked
+	b.Clock = battle.NewClock(o.timeControl)
+	roomID, err := bi.battleRepo.Create(ctx, b)
 	if err != nil {
 		return "", xerrors.Errorf("failed to Create: %w", err)
 	}
 
+	if o.botLevel != 0 {
+		bi.startBot(ctx, roomID, o.botLevel, o.botSeat)
+	}
+	if b.Clock != nil {
+		bi.startClock(ctx, roomID)
+	}
+
 	return roomID, nil
 }
 
@@ -53,26 +118,19 @@ func (bi *battleInteractor) Enter(ctx context.Context, roomID room.ID, loginID s
 		return nil, xerrors.Errorf("failed to Enter: %w", err)
 	}
 
-	_, _, err := bi.battleRepo.ReadStreamLatest(ctx, roomID)
+	lsnr, err := bi.hub.Join(ctx, roomID, loginID)
 	if err != nil {
-		return nil, xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+		return nil, xerrors.Errorf("failed to Join: %w", err)
 	}
 
-	return listener.NewBattleListener(roomID, loginID, bi.battleRepo), nil
+	return lsnr, nil
 }
 
 func (bi *battleInteractor) Declaration(ctx context.Context, roomID room.ID, loginID string) error {
-	_, bt, err := bi.battleRepo.ReadStreamLatest(ctx, roomID)
-	if err != nil {
-		return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
-	}
-
-	if err := bi.battleRule.Declaration(bt, loginID); err != nil {
-		return xerrors.Errorf("failed to Declaration: %w", err)
-	}
-
-	if err := bi.battleRepo.Update(ctx, bt); err != nil {
-		return xerrors.Errorf("failed to Update: %w", err)
+	if err := bi.update(ctx, roomID, func(b *battle.Battle) (*battle.Move, error) {
+		return battle.NewDeclaration(loginID), nil
+	}); err != nil {
+		return xerrors.Errorf("failed to update: %w", err)
 	}
 
 	return nil
@@ -97,50 +155,306 @@ func (bi *battleInteractor) Leave(ctx context.Context, roomID room.ID, loginID s
 	return nil
 }
 
-func (bi *battleInteractor) Attack(ctx context.Context, roomID room.ID, player tictactoe_battle.Player, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error {
-	_, b, err := bi.battleRepo.ReadStreamLatest(ctx, roomID)
-	if err != nil {
-		return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+func (bi *battleInteractor) Attack(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error {
+	if err := bi.verifySession(ctx, login); err != nil {
+		return xerrors.Errorf("failed to verifySession: %w", err)
+	}
+
+	if err := bi.update(ctx, roomID, func(b *battle.Battle) (*battle.Move, error) {
+		player, err := seat(b, login)
+		if err != nil {
+			return nil, err
+		}
+		return battle.NewAttack(player, position, pieceSize), nil
+	}); err != nil {
+		return xerrors.Errorf("failed to update: %w", err)
 	}
 
-	if err := bi.battleRule.Attack(b, player, position, pieceSize); err != nil {
-		return xerrors.Errorf("failed to bt Attack: %w", err)
+	return nil
+}
+
+func (bi *battleInteractor) Pick(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error {
+	if err := bi.verifySession(ctx, login); err != nil {
+		return xerrors.Errorf("failed to verifySession: %w", err)
 	}
 
-	if err := bi.battleRepo.Update(ctx, b); err != nil {
-		return xerrors.Errorf("failed to bt Update: %w", err)
+	if err := bi.update(ctx, roomID, func(b *battle.Battle) (*battle.Move, error) {
+		player, err := seat(b, login)
+		if err != nil {
+			return nil, err
+		}
+		return battle.NewPick(player, position, pieceSize), nil
+	}); err != nil {
+		return xerrors.Errorf("failed to update: %w", err)
 	}
 
 	return nil
 }
 
-func (bi *battleInteractor) Pick(ctx context.Context, roomID room.ID, player tictactoe_battle.Player, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error {
+func (bi *battleInteractor) PlayGobblet(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, a battle.GobbletAction) error {
+	if err := bi.verifySession(ctx, login); err != nil {
+		return xerrors.Errorf("failed to verifySession: %w", err)
+	}
+
+	if err := bi.update(ctx, roomID, func(b *battle.Battle) (*battle.Move, error) {
+		player, err := seat(b, login)
+		if err != nil {
+			return nil, err
+		}
+		return battle.NewGobblet(player, a), nil
+	}); err != nil {
+		return xerrors.Errorf("failed to update: %w", err)
+	}
+
+	return nil
+}
+
+func (bi *battleInteractor) GobbletBoard(ctx context.Context, roomID room.ID) (*battle.GobbletBoard, error) {
 	_, b, err := bi.battleRepo.ReadStreamLatest(ctx, roomID)
 	if err != nil {
-		return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+		return nil, xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+	}
+	if b.Gobblet == nil {
+		return nil, exceptions.NewPreConditionError("room is not in gobblet mode")
+	}
+
+	return b.Gobblet, nil
+}
+
+func (bi *battleInteractor) Timeout(ctx context.Context, roomID room.ID) error {
+	if err := bi.update(ctx, roomID, func(b *battle.Battle) (*battle.Move, error) {
+		if !b.Clock.Expired(b, time.Now()) {
+			return nil, exceptions.NewPreConditionError("time has not run out")
+		}
+		return battle.NewTimeout(b.Turn()), nil
+	}); err != nil {
+		return xerrors.Errorf("failed to update: %w", err)
+	}
+
+	return nil
+}
+
+func (bi *battleInteractor) Resign(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	return bi.playerAction(ctx, roomID, login, battle.NewResign)
+}
+
+func (bi *battleInteractor) OfferDraw(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	return bi.playerAction(ctx, roomID, login, battle.NewOfferDraw)
+}
+
+func (bi *battleInteractor) AcceptDraw(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	return bi.playerAction(ctx, roomID, login, battle.NewAcceptDraw)
+}
+
+func (bi *battleInteractor) DeclineDraw(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	return bi.playerAction(ctx, roomID, login, battle.NewDeclineDraw)
+}
+
+func (bi *battleInteractor) RequestRematch(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	if err := bi.verifySession(ctx, login); err != nil {
+		return xerrors.Errorf("failed to verifySession: %w", err)
+	}
+
+	return bi.rematch(ctx, roomID, login, false)
+}
+
+func (bi *battleInteractor) AcceptRematch(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	if err := bi.verifySession(ctx, login); err != nil {
+		return xerrors.Errorf("failed to verifySession: %w", err)
+	}
+
+	return bi.rematch(ctx, roomID, login, true)
+}
+
+// rematch 相手が再戦を要求していれば席を入れ替えて新しいゲームを始め、そうでなければloginのプレイヤーの要求を記録する.
+//   acceptの場合は相手が要求していなければエラーとする.
+func (bi *battleInteractor) rematch(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, accept bool) error {
+	if err := bi.update(ctx, roomID, func(b *battle.Battle) (*battle.Move, error) {
+		player, err := seat(b, login)
+		if err != nil {
+			return nil, err
+		}
+		if !accept && !b.RematchAgreed(player) {
+			return battle.NewRematchRequest(player), nil
+		}
+
+		rm, err := bi.battleRule.AcceptRematch(b, player)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to AcceptRematch: %w", err)
+		}
+		// 再戦は新しいゲームとして記録する
+		b.GameID = battle.NewGameID(roomID)
+		return battle.NewRematch(b.RuleSet, b.TimeControl(), b.Ranked, rm), nil
+	}); err != nil {
+		return xerrors.Errorf("failed to update: %w", err)
 	}
 
-	if err := bi.battleRule.Pick(b, player, position, pieceSize); err != nil {
-		return xerrors.Errorf("failed to bt Attack: %w", err)
+	return nil
+}
+
+// playerAction 席についているloginのプレイヤーによるnewMoveの操作を適用する.
+func (bi *battleInteractor) playerAction(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, newMove func(player tictactoe_battle.Player) *battle.Move) error {
+	if err := bi.verifySession(ctx, login); err != nil {
+		return xerrors.Errorf("failed to verifySession: %w", err)
 	}
 
-	if err := bi.battleRepo.Update(ctx, b); err != nil {
-		return xerrors.Errorf("failed to bt Update: %w", err)
+	if err := bi.update(ctx, roomID, func(b *battle.Battle) (*battle.Move, error) {
+		player, err := seat(b, login)
+		if err != nil {
+			return nil, err
+		}
+		return newMove(player), nil
+	}); err != nil {
+		return xerrors.Errorf("failed to update: %w", err)
 	}
 
 	return nil
 }
 
-func (bi *battleInteractor) Reset(ctx context.Context, roomID room.ID) error {
+func (bi *battleInteractor) Reset(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error {
+	if err := bi.verifySession(ctx, login); err != nil {
+		return xerrors.Errorf("failed to verifySession: %w", err)
+	}
+
+	if err := bi.update(ctx, roomID, func(b *battle.Battle) (*battle.Move, error) {
+		// 観戦者は終了後のゲームもResetできない
+		player, err := seat(b, login)
+		if err != nil {
+			return nil, err
+		}
+		// 対局中は盤面を消さないよう、両者の要求が揃うまでResetしない
+		if b.Turn() != tictactoe_battle.Player_PLAYER_UNKNOWN {
+			if !b.ResetAgreed(player) {
+				return battle.NewResetRequest(player), nil
+			}
+		}
+
+		// Reset以降は新しいゲームとして記録する
+		b.GameID = battle.NewGameID(roomID)
+		return battle.NewReset(b.RuleSet, b.TimeControl(), b.Ranked), nil
+	}); err != nil {
+		return xerrors.Errorf("failed to update: %w", err)
+	}
+
+	return nil
+}
+
+func (bi *battleInteractor) LegalMoves(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) ([]battle.Action, error) {
+	if err := bi.verifySession(ctx, login); err != nil {
+		return nil, xerrors.Errorf("failed to verifySession: %w", err)
+	}
+
 	_, b, err := bi.battleRepo.ReadStreamLatest(ctx, roomID)
 	if err != nil {
-		return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+		return nil, xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+	}
+	player, err := seat(b, login)
+	if err != nil {
+		return nil, err
 	}
-	bi.battleRule.Reset(b)
 
-	if err := bi.battleRepo.Update(ctx, b); err != nil {
-		return xerrors.Errorf("failed to battle Update: %w", err)
+	return bi.battleRule.LegalMoves(b, player), nil
+}
+
+func (bi *battleInteractor) SuggestMove(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) (*bot.Analysis, error) {
+	if err := bi.verifySession(ctx, login); err != nil {
+		return nil, xerrors.Errorf("failed to verifySession: %w", err)
+	}
+
+	_, b, err := bi.battleRepo.ReadStreamLatest(ctx, roomID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+	}
+	player, err := seat(b, login)
+	if err != nil {
+		return nil, err
+	}
+	if b.Ranked {
+		return nil, exceptions.NewPreConditionError("hints are disabled in ranked rooms")
+	}
+	if b.Gobblet != nil {
+		return nil, exceptions.NewPreConditionError("hints are not available in gobblet mode")
+	}
+	if b.Turn() != player || b.State.IsDrawOffered() {
+		return nil, exceptions.NewPreConditionError("it is not your turn")
+	}
+
+	allowed, err := bi.limitRepo.Allow(ctx, fmt.Sprintf("suggest:%s:%s", b.GameID, login.LoginId), suggestInterval)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Allow: %w", err)
+	}
+	if !allowed {
+		return nil, exceptions.NewResourceExhaustedError(fmt.Sprintf("hints are limited to once per %s", suggestInterval))
+	}
+
+	analysis, err := bot.Analyze(bi.battleRule, bi.tablebase, b)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Analyze: %w", err)
+	}
+
+	return analysis, nil
+}
+
+// update 最新のbattleに対してfnが返すmoveを適用し、moveと共に保存する.
+//   読み込み後に他のリクエストで更新されていた場合は、最新のbattleに対してfnを再適用する.
+func (bi *battleInteractor) update(ctx context.Context, roomID room.ID, fn func(b *battle.Battle) (*battle.Move, error)) error {
+	for i := 1; ; i++ {
+		msgID, b, err := bi.battleRepo.ReadStreamLatest(ctx, roomID)
+		if err != nil {
+			return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+		}
+
+		finished := b.State.IsFinished()
+		move, err := fn(b)
+		if err != nil {
+			return err
+		}
+		// 持ち時間はPlayedAtの時刻で消費するため、適用前に記録する
+		move.PlayedAt = time.Now()
+		if err := battle.Apply(bi.battleRule, b, move); err != nil {
+			return xerrors.Errorf("failed to Apply: %w", err)
+		}
+		move.GameID = b.GameID
+		move.Hash = battle.PositionHash(b)
+
+		err = bi.battleRepo.Update(ctx, msgID, b, move)
+		if err == nil {
+			// 終了後の再戦の要求などで重複して保存しないよう、終了したmoveでのみ保存する.
+			// moveは既に確定しているため、保存はリクエストとは別に再試行しながら行う
+			if !finished && b.State.IsFinished() {
+				bi.settle(ctx, b)
+			}
+			return nil
+		}
+		if !exceptions.IsConflictError(err) || i >= maxUpdateAttempts {
+			return xerrors.Errorf("failed to battle Update: %w", err)
+		}
+	}
+}
+
+// verifySession loginのセッションが登録されているものと一致するか検証する.
+func (bi *battleInteractor) verifySession(ctx context.Context, login *tictactoe_battle.Login) error {
+	if login == nil || login.LoginId == "" {
+		return exceptions.NewInvalidArgumentError("login is required")
+	}
+
+	registered, err := bi.loginRepo.FindByID(ctx, login.LoginId)
+	if err != nil {
+		return xerrors.Errorf("failed to FindByID: %w", err)
+	}
+	if login.SessionId != registered.SessionId {
+		return exceptions.NewPreConditionError("session id does not match")
 	}
 
 	return nil
 }
+
+// seat battle上のloginの席を返す. 観戦者は操作できない.
+func seat(b *battle.Battle, login *tictactoe_battle.Login) (tictactoe_battle.Player, error) {
+	player := b.PlayerOf(login.LoginId)
+	if player == tictactoe_battle.Player_PLAYER_AUDIENCE {
+		return tictactoe_battle.Player_PLAYER_UNKNOWN, exceptions.NewPermissionDeniedError("audience cannot move pieces")
+	}
+
+	return player, nil
+}
@@ -0,0 +1,520 @@
+package interactors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	mock_ports "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/ports"
+	"golang.org/x/xerrors"
+)
+
+func TestBattleInteractor_Attack(t *testing.T) {
+	const (
+		roomID    = room.ID("12345")
+		playerA   = "player_a"
+		playerB   = "player_b"
+		audience  = "audience"
+		sessionID = "session"
+	)
+
+	tests := []struct {
+		name    string
+		login   *tictactoe_battle.Login
+		wantErr func(err error) bool
+		updated bool
+	}{
+		{
+			name:    "player in turn",
+			login:   &tictactoe_battle.Login{LoginId: playerA, SessionId: sessionID},
+			updated: true,
+		},
+		{
+			name:    "player out of turn",
+			login:   &tictactoe_battle.Login{LoginId: playerB, SessionId: sessionID},
+			wantErr: func(err error) bool { return err != nil },
+		},
+		{
+			name:    "audience",
+			login:   &tictactoe_battle.Login{LoginId: audience, SessionId: sessionID},
+			wantErr: exceptions.IsPermissionDeniedError,
+		},
+		{
+			name:    "session mismatch",
+			login:   &tictactoe_battle.Login{LoginId: playerA, SessionId: "other"},
+			wantErr: exceptions.IsSessionMismatchError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rule := battle.NewRule()
+			b := rule.OpenBattle(battle.DefaultRuleSet())
+			b.RoomID = roomID
+			if err := rule.Declaration(b, playerA); err != nil {
+				t.Fatalf("failed to Declaration: %v", err)
+			}
+			if err := rule.Declaration(b, playerB); err != nil {
+				t.Fatalf("failed to Declaration: %v", err)
+			}
+
+			battleRepo := mock_ports.NewMockBattleRepository(ctrl)
+			battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-1", b, nil).MaxTimes(1)
+			if tt.updated {
+				battleRepo.EXPECT().Update(gomock.Any(), "0-1", b, gomock.Any()).Return(nil)
+			}
+
+			loginRepo := mock_ports.NewMockLoginRepository(ctrl)
+			loginRepo.EXPECT().FindByID(gomock.Any(), tt.login.LoginId).Return(&tictactoe_battle.Login{
+				LoginId:   tt.login.LoginId,
+				SessionId: sessionID,
+			}, nil)
+
+			bi := &battleInteractor{
+				battleRule: rule,
+				battleRepo: battleRepo,
+				loginRepo:  loginRepo,
+			}
+
+			err := bi.Attack(context.Background(), roomID, tt.login, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L)
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != nil && !tt.wantErr(err):
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestBattleInteractor_Attack_Conflict(t *testing.T) {
+	const (
+		roomID    = room.ID("12345")
+		playerA   = "player_a"
+		playerB   = "player_b"
+		sessionID = "session"
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rule := battle.NewRule()
+	newBattle := func() *battle.Battle {
+		b := rule.OpenBattle(battle.DefaultRuleSet())
+		b.RoomID = roomID
+		_ = rule.Declaration(b, playerA)
+		_ = rule.Declaration(b, playerB)
+		return b
+	}
+	stale, latest := newBattle(), newBattle()
+
+	battleRepo := mock_ports.NewMockBattleRepository(ctrl)
+	gomock.InOrder(
+		battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-1", stale, nil),
+		battleRepo.EXPECT().Update(gomock.Any(), "0-1", stale, gomock.Any()).Return(exceptions.NewConflictError("conflict")),
+		battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-2", latest, nil),
+		battleRepo.EXPECT().Update(gomock.Any(), "0-2", latest, gomock.Any()).Return(nil),
+	)
+
+	loginRepo := mock_ports.NewMockLoginRepository(ctrl)
+	loginRepo.EXPECT().FindByID(gomock.Any(), playerA).Return(&tictactoe_battle.Login{LoginId: playerA, SessionId: sessionID}, nil)
+
+	bi := &battleInteractor{
+		battleRule: rule,
+		battleRepo: battleRepo,
+		loginRepo:  loginRepo,
+	}
+
+	login := &tictactoe_battle.Login{LoginId: playerA, SessionId: sessionID}
+	if err := bi.Attack(context.Background(), roomID, login, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestBattleInteractor_Reset_Finished(t *testing.T) {
+	const (
+		roomID    = room.ID("12345")
+		playerA   = "player_a"
+		playerB   = "player_b"
+		audience  = "audience"
+		sessionID = "session"
+	)
+
+	tests := []struct {
+		name    string
+		login   *tictactoe_battle.Login
+		wantErr func(err error) bool
+		updated bool
+	}{
+		{
+			name:    "player",
+			login:   &tictactoe_battle.Login{LoginId: playerB, SessionId: sessionID},
+			updated: true,
+		},
+		{
+			name:    "audience",
+			login:   &tictactoe_battle.Login{LoginId: audience, SessionId: sessionID},
+			wantErr: exceptions.IsPermissionDeniedError,
+		},
+		{
+			name:    "session mismatch",
+			login:   &tictactoe_battle.Login{LoginId: playerA, SessionId: "other"},
+			wantErr: exceptions.IsSessionMismatchError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rule := battle.NewRule()
+			b := rule.OpenBattle(battle.DefaultRuleSet())
+			b.RoomID = roomID
+			_ = rule.Declaration(b, playerA)
+			_ = rule.Declaration(b, playerB)
+			if err := rule.Resign(b, tictactoe_battle.Player_PLAYER_A); err != nil {
+				t.Fatalf("failed to Resign: %v", err)
+			}
+
+			battleRepo := mock_ports.NewMockBattleRepository(ctrl)
+			battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-1", b, nil).MaxTimes(1)
+			if tt.updated {
+				battleRepo.EXPECT().Update(gomock.Any(), "0-1", b, gomock.Any()).Return(nil)
+			}
+
+			loginRepo := mock_ports.NewMockLoginRepository(ctrl)
+			loginRepo.EXPECT().FindByID(gomock.Any(), tt.login.LoginId).Return(&tictactoe_battle.Login{
+				LoginId:   tt.login.LoginId,
+				SessionId: sessionID,
+			}, nil)
+
+			bi := &battleInteractor{
+				battleRule: rule,
+				battleRepo: battleRepo,
+				loginRepo:  loginRepo,
+			}
+
+			err := bi.Reset(context.Background(), roomID, tt.login)
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != nil && !tt.wantErr(err):
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestBattleInteractor_Resign_SaveFailed(t *testing.T) {
+	const (
+		roomID    = room.ID("12345")
+		playerA   = "player_a"
+		playerB   = "player_b"
+		sessionID = "session"
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rule := battle.NewRule()
+	b := rule.OpenBattle(battle.DefaultRuleSet())
+	b.RoomID = roomID
+	b.GameID = battle.NewGameID(roomID)
+	_ = rule.Declaration(b, playerA)
+	_ = rule.Declaration(b, playerB)
+
+	battleRepo := mock_ports.NewMockBattleRepository(ctrl)
+	battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-1", b, nil)
+	battleRepo.EXPECT().Update(gomock.Any(), "0-1", b, gomock.Any()).Return(nil)
+	battleRepo.EXPECT().ListMoves(gomock.Any(), roomID).Return(nil, nil).Times(settleAttempts)
+	loginRepo := mock_ports.NewMockLoginRepository(ctrl)
+	loginRepo.EXPECT().FindByID(gomock.Any(), playerA).Return(&tictactoe_battle.Login{LoginId: playerA, SessionId: sessionID}, nil)
+	// 保存に失敗した場合は再試行する
+	replayRepo := mock_ports.NewMockReplayRepository(ctrl)
+	replayRepo.EXPECT().Save(gomock.Any(), b.GameID, gomock.Any()).Return(xerrors.New("unavailable")).Times(settleAttempts)
+
+	bi := &battleInteractor{
+		battleRule:    rule,
+		battleRepo:    battleRepo,
+		loginRepo:     loginRepo,
+		replayRepo:    replayRepo,
+		settleBackoff: time.Millisecond,
+	}
+
+	// 投了のmoveは確定しているため、保存の失敗はリクエストのエラーとしない
+	login := &tictactoe_battle.Login{LoginId: playerA, SessionId: sessionID}
+	if err := bi.Resign(context.Background(), roomID, login); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bi.settling.Wait()
+}
+
+func TestBattleInteractor_ReconcileRatings(t *testing.T) {
+	const (
+		playerA = "player_a"
+		playerB = "player_b"
+	)
+	game := &archive.Game{
+		GameID:    battle.GameID("12345-1"),
+		PlayerAID: playerA,
+		PlayerBID: playerB,
+		Ranked:    true,
+		Result:    archive.ResultPlayerBWin,
+	}
+
+	tests := []struct {
+		name  string
+		rated bool
+	}{
+		{name: "not rated"},
+		// 終了時の反映と同時に実行された場合は保存済みの変化を使う
+		{name: "rated concurrently", rated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			archiveRepo := mock_ports.NewMockGameArchiveRepository(ctrl)
+			archiveRepo.EXPECT().ListUnrated(gomock.Any(), reconcileBatchSize).Return([]*archive.Game{game}, nil)
+
+			ratingRepo := mock_ports.NewMockRatingRepository(ctrl)
+			ratingRepo.EXPECT().Find(gomock.Any(), playerA).Return(rating.NewRating(playerA), nil).AnyTimes()
+			ratingRepo.EXPECT().Find(gomock.Any(), playerB).Return(rating.NewRating(playerB), nil).AnyTimes()
+			if tt.rated {
+				ratingRepo.EXPECT().FindResult(gomock.Any(), game.GameID).Return(&rating.Result{}, nil)
+			} else {
+				ratingRepo.EXPECT().FindResult(gomock.Any(), game.GameID).Return(nil, exceptions.NewNotFoundError("not rated"))
+				ratingRepo.EXPECT().Save(gomock.Any(), game.GameID, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ battle.GameID, result *rating.Result, _ ...*rating.Rating) error {
+						if result.PlayerA.Delta() >= 0 || result.PlayerB.Delta() <= 0 {
+							t.Fatalf("unexpected result: %+v", result)
+						}
+						return nil
+					})
+			}
+
+			seasonRepo := mock_ports.NewMockSeasonRepository(ctrl)
+			seasonRepo.EXPECT().Current(gomock.Any()).Return(1, nil).Times(2)
+			boardRepo := mock_ports.NewMockLeaderboardRepository(ctrl)
+			boardRepo.EXPECT().Record(gomock.Any(), 1, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+			bi := &battleInteractor{
+				archiveRepo: archiveRepo,
+				ratingRepo:  ratingRepo,
+				boardRepo:   boardRepo,
+				seasonRepo:  seasonRepo,
+			}
+			if err := bi.ReconcileRatings(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestBattleInteractor_Resign_Ranked(t *testing.T) {
+	const (
+		roomID    = room.ID("12345")
+		playerA   = "player_a"
+		playerB   = "player_b"
+		sessionID = "session"
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rule := battle.NewRule()
+	newBattle := func() *battle.Battle {
+		b := rule.OpenBattle(battle.DefaultRuleSet())
+		b.RoomID = roomID
+		b.GameID = battle.NewGameID(roomID)
+		b.Ranked = true
+		_ = rule.Declaration(b, playerA)
+		_ = rule.Declaration(b, playerB)
+		return b
+	}
+	playing := newBattle()
+	finished := playing.Clone()
+	_ = rule.Resign(finished, tictactoe_battle.Player_PLAYER_A)
+
+	battleRepo := mock_ports.NewMockBattleRepository(ctrl)
+	var rated *battle.Move
+	gomock.InOrder(
+		battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-1", playing, nil),
+		battleRepo.EXPECT().Update(gomock.Any(), "0-1", playing, gomock.Any()).Return(nil),
+		battleRepo.EXPECT().ListMoves(gomock.Any(), roomID).Return(nil, nil),
+		battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-2", finished, nil),
+		battleRepo.EXPECT().Update(gomock.Any(), "0-2", finished, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ *battle.Battle, m *battle.Move) error {
+				rated = m
+				return nil
+			}),
+	)
+
+	loginRepo := mock_ports.NewMockLoginRepository(ctrl)
+	loginRepo.EXPECT().FindByID(gomock.Any(), playerA).Return(&tictactoe_battle.Login{LoginId: playerA, SessionId: sessionID}, nil)
+	replayRepo := mock_ports.NewMockReplayRepository(ctrl)
+	replayRepo.EXPECT().Save(gomock.Any(), playing.GameID, gomock.Any()).Return(nil)
+	archiveRepo := mock_ports.NewMockGameArchiveRepository(ctrl)
+	archiveRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+	// 同時に終了した別の対局と競合した場合は読み込みからやり直す
+	ratingRepo := mock_ports.NewMockRatingRepository(ctrl)
+	ratingRepo.EXPECT().FindResult(gomock.Any(), playing.GameID).Return(nil, exceptions.NewNotFoundError("not rated")).Times(2)
+	ratingRepo.EXPECT().Find(gomock.Any(), playerA).Return(rating.NewRating(playerA), nil).Times(3)
+	ratingRepo.EXPECT().Find(gomock.Any(), playerB).Return(rating.NewRating(playerB), nil).Times(3)
+	gomock.InOrder(
+		ratingRepo.EXPECT().Save(gomock.Any(), playing.GameID, gomock.Any(), gomock.Any(), gomock.Any()).Return(exceptions.NewConflictError("conflict")),
+		ratingRepo.EXPECT().Save(gomock.Any(), playing.GameID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	seasonRepo := mock_ports.NewMockSeasonRepository(ctrl)
+	seasonRepo.EXPECT().Current(gomock.Any()).Return(2, nil).Times(2)
+	boardRepo := mock_ports.NewMockLeaderboardRepository(ctrl)
+	boardRepo.EXPECT().Record(gomock.Any(), 2, playerA, gomock.Any(), gomock.Any()).Return(nil)
+	boardRepo.EXPECT().Record(gomock.Any(), 2, playerB, gomock.Any(), gomock.Any()).Return(nil)
+
+	bi := &battleInteractor{
+		battleRule:  rule,
+		battleRepo:  battleRepo,
+		loginRepo:   loginRepo,
+		replayRepo:  replayRepo,
+		archiveRepo: archiveRepo,
+		ratingRepo:  ratingRepo,
+		boardRepo:   boardRepo,
+		seasonRepo:  seasonRepo,
+	}
+
+	login := &tictactoe_battle.Login{LoginId: playerA, SessionId: sessionID}
+	if err := bi.Resign(context.Background(), roomID, login); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bi.settling.Wait()
+	if rated == nil || rated.Type != battle.MoveRated {
+		t.Fatalf("wanted rated move but got %+v", rated)
+	}
+	if r := finished.Ratings; r == nil || r.PlayerA.Delta() >= 0 || r.PlayerB.Delta() <= 0 {
+		t.Fatalf("unexpected ratings: %+v", r)
+	}
+}
+
+func TestBattleInteractor_SuggestMove(t *testing.T) {
+	const (
+		roomID    = room.ID("12345")
+		playerA   = "player_a"
+		playerB   = "player_b"
+		sessionID = "session"
+	)
+
+	tests := []struct {
+		name    string
+		loginID string
+		ranked  bool
+		allowed bool
+		wantErr func(err error) bool
+	}{
+		{
+			name:    "player in turn",
+			loginID: playerA,
+			allowed: true,
+		},
+		{
+			name:    "rate limited",
+			loginID: playerA,
+			wantErr: exceptions.IsResourceExhaustedError,
+		},
+		{
+			name:    "player out of turn",
+			loginID: playerB,
+			wantErr: exceptions.IsSessionMismatchError,
+		},
+		{
+			name:    "ranked room",
+			loginID: playerA,
+			ranked:  true,
+			wantErr: exceptions.IsSessionMismatchError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rule := battle.NewRule()
+			b := rule.OpenBattle(battle.DefaultRuleSet())
+			b.RoomID = roomID
+			b.Ranked = tt.ranked
+			_ = rule.Declaration(b, playerA)
+			_ = rule.Declaration(b, playerB)
+
+			battleRepo := mock_ports.NewMockBattleRepository(ctrl)
+			battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-1", b, nil)
+
+			loginRepo := mock_ports.NewMockLoginRepository(ctrl)
+			loginRepo.EXPECT().FindByID(gomock.Any(), tt.loginID).Return(&tictactoe_battle.Login{LoginId: tt.loginID, SessionId: sessionID}, nil)
+
+			limitRepo := mock_ports.NewMockRateLimitRepository(ctrl)
+			limitRepo.EXPECT().Allow(gomock.Any(), gomock.Any(), suggestInterval).Return(tt.allowed, nil).MaxTimes(1)
+
+			bi := &battleInteractor{
+				battleRule: rule,
+				battleRepo: battleRepo,
+				loginRepo:  loginRepo,
+				limitRepo:  limitRepo,
+			}
+
+			analysis, err := bi.SuggestMove(context.Background(), roomID, &tictactoe_battle.Login{LoginId: tt.loginID, SessionId: sessionID})
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != nil && !tt.wantErr(err):
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr == nil && analysis.Action.Piece == tictactoe_battle.Piece_PIECE_UNKNOWN:
+				t.Fatalf("unexpected analysis: %+v", analysis)
+			}
+		})
+	}
+}
+
+func TestBattleInteractor_RecordLeaderboard_SeasonClosed(t *testing.T) {
+	const playerA = "player_a"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ratingRepo := mock_ports.NewMockRatingRepository(ctrl)
+	ratingRepo.EXPECT().Find(gomock.Any(), playerA).Return(rating.NewRating(playerA), nil).Times(2)
+
+	// 記録の間にseasonが終了した場合は次のseasonにも記録する
+	seasonRepo := mock_ports.NewMockSeasonRepository(ctrl)
+	boardRepo := mock_ports.NewMockLeaderboardRepository(ctrl)
+	gomock.InOrder(
+		seasonRepo.EXPECT().Current(gomock.Any()).Return(1, nil),
+		boardRepo.EXPECT().Record(gomock.Any(), 1, playerA, gomock.Any(), gomock.Any()).Return(nil),
+		seasonRepo.EXPECT().Current(gomock.Any()).Return(2, nil),
+		boardRepo.EXPECT().Record(gomock.Any(), 2, playerA, gomock.Any(), gomock.Any()).Return(nil),
+		seasonRepo.EXPECT().Current(gomock.Any()).Return(2, nil),
+	)
+
+	bi := &battleInteractor{
+		ratingRepo: ratingRepo,
+		boardRepo:  boardRepo,
+		seasonRepo: seasonRepo,
+	}
+	if err := bi.recordLeaderboard(context.Background(), playerA); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
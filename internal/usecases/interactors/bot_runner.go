@@ -0,0 +1,163 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/bot"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+)
+
+type (
+	CreateOption func(o *createOptions)
+
+	createOptions struct {
+		botLevel bot.Level
+		botSeat  tictactoe_battle.Player
+		ranked   bool
+		ruleSet  battle.RuleSet
+		// timeControl ゼロ値の場合は持ち時間なし.
+		timeControl battle.TimeControl
+	}
+)
+
+// WithTimeControl 作成したroomでtcの持ち時間を使用する.
+func WithTimeControl(tc battle.TimeControl) CreateOption {
+	return func(o *createOptions) {
+		o.timeControl = tc
+	}
+}
+
+// WithRuleSet 作成したroomでrsのルールを使用する. 省略時はDefaultRuleSet.
+func WithRuleSet(rs battle.RuleSet) CreateOption {
+	return func(o *createOptions) {
+		o.ruleSet = rs
+	}
+}
+
+// WithRanked 作成したroomをレーティング対象とする. ヒントは使用できずbotとも併用できない.
+func WithRanked() CreateOption {
+	return func(o *createOptions) {
+		o.ranked = true
+	}
+}
+
+// WithBot 作成したroomにbotを参加させる. seatがPLAYER_Bの場合は最初のプレイヤーの参加を待ってから席につく.
+func WithBot(level bot.Level, seat tictactoe_battle.Player) CreateOption {
+	return func(o *createOptions) {
+		o.botLevel = level
+		o.botSeat = seat
+	}
+}
+
+// startBot botをroomに参加させ、手番が来るたびに着手させる.
+//   botはroomのmemberとはならず、roomが削除されるか一定時間更新が無い場合に停止する.
+//   botはroomを作成したプロセス内でのみ動作する.
+func (bi *battleInteractor) startBot(ctx context.Context, roomID room.ID, level bot.Level, seat tictactoe_battle.Player) {
+	logger := loggers.Logger(ctx).With(zap.String("room_id", roomID.String()), zap.String("bot", level.ID()))
+	botCtx, cancel := context.WithCancel(loggers.LoggerToContext(context.Background(), logger))
+
+	lsnr, err := bi.hub.Join(botCtx, roomID, level.ID())
+	if err != nil {
+		cancel()
+		logger.Warn("failed to start bot", zap.Error(err))
+		return
+	}
+
+	bt := bot.New(level, bi.battleRule, bi.tablebase)
+	go func() {
+		defer cancel()
+
+		for {
+			listenCtx, cancelListen := context.WithTimeout(botCtx, room.TimeoutDuration)
+			bs, err := lsnr.Listen(listenCtx)
+			cancelListen()
+			if err != nil {
+				logger.Info("bot stopped", zap.Error(err))
+				return
+			}
+
+			if err := bi.botAct(botCtx, roomID, bt, level.ID(), seat, bs); err != nil {
+				logger.Warn("failed to bot act", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// botAct battleの状況に応じて席につくか着手する.
+func (bi *battleInteractor) botAct(ctx context.Context, roomID room.ID, bt *bot.Bot, botID string, seat tictactoe_battle.Player, ext *tictactoe_battle_ext.BattleSituation) error {
+	switch ext.Status {
+	case tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW:
+		return bi.botRematch(ctx, roomID, botID)
+
+	case tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW_OFFERED:
+		// botは引き分けの提案に応じない
+		if err := bi.update(ctx, roomID, func(b *battle.Battle) (*battle.Move, error) {
+			return battle.NewDeclineDraw(b.PlayerOf(botID)), nil
+		}); err != nil {
+			return xerrors.Errorf("failed to update: %w", err)
+		}
+		return nil
+	}
+
+	bs := ext.Situation
+	switch bs.State {
+	case tictactoe_battle.BattleState_BATTLE_STATE_MEETING:
+		if bs.Player != tictactoe_battle.Player_PLAYER_AUDIENCE {
+			return nil
+		}
+		if seat == tictactoe_battle.Player_PLAYER_B && (bs.PlayerAId == "" || bs.PlayerBId != "") {
+			return nil
+		}
+		if err := bi.Declaration(ctx, roomID, botID); err != nil {
+			return xerrors.Errorf("failed to Declaration: %w", err)
+		}
+
+	case tictactoe_battle.BattleState_BATTLE_STATE_PLAYER_TURN, tictactoe_battle.BattleState_BATTLE_STATE_PLAYER_TURN_PICKED:
+		if err := bi.update(ctx, roomID, func(b *battle.Battle) (*battle.Move, error) {
+			player := b.PlayerOf(botID)
+			if b.Turn() != player {
+				return nil, exceptions.NewPreConditionError("it is not bot's turn")
+			}
+
+			a, err := bt.Think(b)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to Think: %w", err)
+			}
+			// 盤上の駒を動かす場合は持ち上げた後の局面で改めて置き場所を選ぶ
+			if a.IsRelocation() && b.PickedPosition == tictactoe_battle.Position_POSITION_UNDEFINED {
+				return battle.NewPick(player, a.From, a.Piece), nil
+			}
+			return battle.NewAttack(player, a.To, a.Piece), nil
+		}); err != nil {
+			return xerrors.Errorf("failed to update: %w", err)
+		}
+
+	case tictactoe_battle.BattleState_BATTLE_STATE_WIN, tictactoe_battle.BattleState_BATTLE_STATE_LOSE:
+		return bi.botRematch(ctx, roomID, botID)
+	}
+
+	return nil
+}
+
+// botRematch botは常に再戦を要求する. 要求済みの場合は相手の要求を待つ.
+func (bi *battleInteractor) botRematch(ctx context.Context, roomID room.ID, botID string) error {
+	_, b, err := bi.battleRepo.ReadStreamLatest(ctx, roomID)
+	if err != nil {
+		return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+	}
+	if b.RematchRequestedBy == b.PlayerOf(botID) {
+		return nil
+	}
+	if err := bi.rematch(ctx, roomID, &tictactoe_battle.Login{LoginId: botID}, false); err != nil {
+		return xerrors.Errorf("failed to rematch: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// internalIDPrefix プロセス内部でhubを購読する際のIDの接頭辞. プレイヤーと重複しないようLoginInteractorで使用を禁止している.
+	internalIDPrefix = "internal:"
+	// clockID 時計がhubを購読する際のID.
+	clockID = internalIDPrefix + "clock"
+)
+
+// startClock 持ち時間のあるroomを監視し、手番のプレイヤーの持ち時間が切れた時点で負けとする.
+//   clientからのリクエストが無くても時間切れとなる. botと同様にroomを作成したプロセス内でのみ動作し、
+//   roomが削除されるか一定時間更新が無い場合に停止する.
+func (bi *battleInteractor) startClock(ctx context.Context, roomID room.ID) {
+	logger := loggers.Logger(ctx).With(zap.String("room_id", roomID.String()))
+	clockCtx, cancel := context.WithCancel(loggers.LoggerToContext(context.Background(), logger))
+
+	lsnr, err := bi.hub.Join(clockCtx, roomID, clockID)
+	if err != nil {
+		cancel()
+		logger.Warn("failed to start clock", zap.Error(err))
+		return
+	}
+
+	go func() {
+		defer cancel()
+
+		for {
+			_, b, err := bi.battleRepo.ReadStreamLatest(clockCtx, roomID)
+			if err != nil {
+				logger.Info("clock stopped", zap.Error(err))
+				return
+			}
+
+			// 更新が無ければ持ち時間が切れるまで待ち、更新があれば期限を計算し直す
+			wait := room.TimeoutDuration
+			deadline, running := b.Clock.Deadline(b)
+			if running {
+				wait = time.Until(deadline)
+			}
+			listenCtx, cancelListen := context.WithTimeout(clockCtx, wait)
+			_, err = lsnr.Listen(listenCtx)
+			cancelListen()
+
+			switch {
+			case err == nil:
+			case running && clockCtx.Err() == nil && xerrors.Is(err, context.DeadlineExceeded):
+				if err := bi.Timeout(clockCtx, roomID); err != nil {
+					logger.Warn("failed to Timeout", zap.Error(err))
+				}
+			default:
+				logger.Info("clock stopped", zap.Error(err))
+				return
+			}
+		}
+	}()
+}
@@ -9,18 +9,27 @@ type (
 	Factory interface {
 		LoginInteractor() LoginInteractor
 		BattleInteractor() BattleInteractor
+		ReplayInteractor() ReplayInteractor
+		PlayerInteractor() PlayerInteractor
+		LeaderboardInteractor() LeaderboardInteractor
 	}
 
 	factory struct {
-		loginInteractor  LoginInteractor
-		battleInteractor BattleInteractor
+		loginInteractor       LoginInteractor
+		battleInteractor      BattleInteractor
+		replayInteractor      ReplayInteractor
+		playerInteractor      PlayerInteractor
+		leaderboardInteractor LeaderboardInteractor
 	}
 )
 
 func NewFactory(dFactory domains.Factory, rFactory ports.RepositoriesFactory) Factory {
 	return &factory{
-		loginInteractor:  NewLoginInteractor(rFactory),
-		battleInteractor: NewBattleInteractor(dFactory, rFactory),
+		loginInteractor:       NewLoginInteractor(rFactory),
+		battleInteractor:      NewBattleInteractor(dFactory, rFactory),
+		replayInteractor:      NewReplayInteractor(dFactory, rFactory),
+		playerInteractor:      NewPlayerInteractor(rFactory),
+		leaderboardInteractor: NewLeaderboardInteractor(rFactory),
 	}
 }
 
@@ -31,3 +40,15 @@ func (f factory) LoginInteractor() LoginInteractor {
 func (f factory) BattleInteractor() BattleInteractor {
 	return f.battleInteractor
 }
+
+func (f factory) ReplayInteractor() ReplayInteractor {
+	return f.replayInteractor
+}
+
+func (f factory) PlayerInteractor() PlayerInteractor {
+	return f.playerInteractor
+}
+
+func (f factory) LeaderboardInteractor() LeaderboardInteractor {
+	return f.leaderboardInteractor
+}
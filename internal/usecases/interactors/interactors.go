@@ -5,24 +5,86 @@ import (
 	"context"
 
 	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/bot"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/leaderboard"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
 )
 
 type (
 	LoginInteractor interface {
 		Login(ctx context.Context, login *tictactoe_battle.Login) (*tictactoe_battle.Login, error)
 		Logout(ctx context.Context, login *tictactoe_battle.Login) error
+		Authenticate(ctx context.Context, login *tictactoe_battle.Login) error
 	}
 
 	BattleInteractor interface {
-		Create(ctx context.Context) (room.ID, error)
+		Create(ctx context.Context, opts ...CreateOption) (room.ID, error)
 		CanEnter(ctx context.Context, roomID room.ID, loginID string) (bool, error)
 		Enter(ctx context.Context, roomID room.ID, loginID string) (ports.BattleListener, error)
 		Declaration(ctx context.Context, roomID room.ID, loginID string) error
 		Leave(ctx context.Context, roomID room.ID, loginID string) error
-		Attack(ctx context.Context, roomID room.ID, player tictactoe_battle.Player, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error
-		Pick(ctx context.Context, roomID room.ID, player tictactoe_battle.Player, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error
-		Reset(ctx context.Context, roomID room.ID) error
+		Attack(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error
+		Pick(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, position tictactoe_battle.Position, pieceSize tictactoe_battle.Piece) error
+		// PlayGobblet ModeGobbletのroomでloginのプレイヤーがaを着手する.
+		PlayGobblet(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login, a battle.GobbletAction) error
+		// GobbletBoard ModeGobbletのroomの盤面を返す. BattleSituationは4x4の盤面を表せないため別に取得する.
+		GobbletBoard(ctx context.Context, roomID room.ID) (*battle.GobbletBoard, error)
+		// Timeout 手番のプレイヤーの持ち時間が切れていれば負けとする. 持ち時間のあるroomでは自動的に呼び出される.
+		Timeout(ctx context.Context, roomID room.ID) error
+		// Resign loginのプレイヤーが投了する.
+		Resign(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error
+		// OfferDraw 手番のloginのプレイヤーが引き分けを提案する.
+		OfferDraw(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error
+		// AcceptDraw loginのプレイヤーが相手の引き分けの提案に応じる.
+		AcceptDraw(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error
+		// DeclineDraw loginのプレイヤーが相手の引き分けの提案を断る.
+		DeclineDraw(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error
+		// RequestRematch ゲームの終了後にloginのプレイヤーが再戦を要求する. 相手が既に要求している場合は再戦を始める.
+		RequestRematch(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error
+		// AcceptRematch loginのプレイヤーが相手の再戦の要求に応じ、席を入れ替えて新しいゲームを始める.
+		AcceptRematch(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error
+		// Reset 新しいゲームを始める. 対局中は両者がResetを要求した時点で始まる.
+		Reset(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) error
+		// LegalMoves loginのプレイヤーが現在の局面で選べる全ての着手を返す.
+		LegalMoves(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) ([]battle.Action, error)
+		// SuggestMove 手番のloginのプレイヤーに最善手と評価を返す. ゲームごとに回数が制限され、ranked roomでは使用できない.
+		SuggestMove(ctx context.Context, roomID room.ID, login *tictactoe_battle.Login) (*bot.Analysis, error)
+		// ReconcileRatings 記録済みでratingに反映されていないranked roomのゲームを終了の古い順に反映する.
+		//   終了時の反映がエラーやプロセスの停止で失敗した場合に備え、起動時と一定間隔で呼び出す.
+		ReconcileRatings(ctx context.Context) error
+	}
+
+	ReplayInteractor interface {
+		// GetReplay ゲームの局面をmoveごとに返す. 終了済みのゲームと進行中のゲームのどちらも対象.
+		GetReplay(ctx context.Context, gameID battle.GameID, loginID string) ([]*tictactoe_battle_ext.BattleSituation, error)
+		// StreamReplay ゲームの局面を実際の間隔をspeed倍速にして配信するlistenerを返す.
+		StreamReplay(ctx context.Context, gameID battle.GameID, loginID string, speed float64) (ports.BattleListener, error)
+	}
+
+	PlayerInteractor interface {
+		// GetPlayerProfile loginIDのプレイヤーの記録されたゲームの通算成績を返す. 記録が無い場合は全てゼロの成績.
+		GetPlayerProfile(ctx context.Context, loginID string) (*archive.Profile, error)
+		// ListMatchHistory loginIDのプレイヤーの過去のゲームを新しい順にpageSize件ずつ返す.
+		//   pageTokenには前回のNextPageTokenを指定し、最初のページは空文字とする.
+		ListMatchHistory(ctx context.Context, loginID string, pageToken string, pageSize int) (*archive.MatchHistory, error)
+		// GetRating loginIDのプレイヤーの現在のratingを返す. RDは対局の無い期間の分だけ増やしたもの.
+		GetRating(ctx context.Context, loginID string) (*rating.Rating, error)
+	}
+
+	LeaderboardInteractor interface {
+		// GetLeaderboard 進行中のseasonのboardの順位表をratingの高い順にpageSize件ずつ返す.
+		//   pageTokenには前回のNextPageTokenを指定し、最初のページは空文字とする.
+		GetLeaderboard(ctx context.Context, board leaderboard.Board, pageToken string, pageSize int) (*leaderboard.Page, error)
+		// GetMyRank 進行中のseasonのboardの順位表でのloginIDのプレイヤーの順位を返す. 載っていない場合はNotFoundError.
+		GetMyRank(ctx context.Context, board leaderboard.Board, loginID string) (*leaderboard.Entry, error)
+		// CloseSeason 進行中のseasonを終了し、通算の順位表の最終結果を記録して順位表を空にする.
+		CloseSeason(ctx context.Context) (*leaderboard.Season, error)
+		// GetSeason 終了したseasonの最終結果を返す.
+		GetSeason(ctx context.Context, number int) (*leaderboard.Season, error)
 	}
 )
@@ -0,0 +1,130 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/leaderboard"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"golang.org/x/xerrors"
+)
+
+const (
+	defaultLeaderboardPageSize = 20
+	maxLeaderboardPageSize     = 100
+)
+
+type (
+	leaderboardInteractor struct {
+		leaderboardRepo ports.LeaderboardRepository
+		seasonRepo      ports.SeasonRepository
+	}
+)
+
+func NewLeaderboardInteractor(rFactory ports.RepositoriesFactory) LeaderboardInteractor {
+	return &leaderboardInteractor{
+		leaderboardRepo: rFactory.LeaderboardRepository(),
+		seasonRepo:      rFactory.SeasonRepository(),
+	}
+}
+
+func (li *leaderboardInteractor) GetLeaderboard(ctx context.Context, board leaderboard.Board, pageToken string, pageSize int) (*leaderboard.Page, error) {
+	if err := board.Validate(); err != nil {
+		return nil, err
+	}
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultLeaderboardPageSize
+	case pageSize > maxLeaderboardPageSize:
+		pageSize = maxLeaderboardPageSize
+	}
+
+	// pageTokenは次のページの先頭の件数
+	offset := 0
+	if pageToken != "" {
+		var err error
+		if offset, err = strconv.Atoi(pageToken); err != nil || offset < 0 {
+			return nil, exceptions.NewInvalidArgumentError(fmt.Sprintf("invalid page token: %s", pageToken))
+		}
+	}
+
+	season, err := li.seasonRepo.Current(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Current season: %w", err)
+	}
+
+	// 次のページの有無を判定するため1件多く取得する
+	entries, err := li.leaderboardRepo.List(ctx, season, board, time.Now(), offset, pageSize+1)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to List: %w", err)
+	}
+
+	ret := &leaderboard.Page{Board: board, Season: season, Entries: entries}
+	if len(entries) > pageSize {
+		ret.Entries = entries[:pageSize]
+		ret.NextPageToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return ret, nil
+}
+
+func (li *leaderboardInteractor) GetMyRank(ctx context.Context, board leaderboard.Board, loginID string) (*leaderboard.Entry, error) {
+	if err := board.Validate(); err != nil {
+		return nil, err
+	}
+	if loginID == "" {
+		return nil, exceptions.NewInvalidArgumentError("login id is required")
+	}
+
+	season, err := li.seasonRepo.Current(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Current season: %w", err)
+	}
+
+	entry, err := li.leaderboardRepo.Rank(ctx, season, board, time.Now(), loginID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Rank: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (li *leaderboardInteractor) CloseSeason(ctx context.Context) (*leaderboard.Season, error) {
+	number, err := li.seasonRepo.Current(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Current season: %w", err)
+	}
+
+	// 先に終了を記録し、以降のratingの更新は次のseasonの順位表に載せる.
+	//   終了の前にseasonを読み込んだ記録は、記録後にseasonを確認して次のseasonにも記録される
+	now := time.Now()
+	if err := li.seasonRepo.Close(ctx, number, now); err != nil {
+		return nil, xerrors.Errorf("failed to Close season: %w", err)
+	}
+
+	standings, err := li.leaderboardRepo.List(ctx, number, leaderboard.BoardAllTime, now, 0, 0)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to List: %w", err)
+	}
+	if err := li.seasonRepo.SaveStandings(ctx, number, standings); err != nil {
+		return nil, xerrors.Errorf("failed to SaveStandings: %w", err)
+	}
+	if err := li.leaderboardRepo.Clear(ctx, number); err != nil {
+		return nil, xerrors.Errorf("failed to Clear: %w", err)
+	}
+
+	season := &leaderboard.Season{Number: number, ClosedAt: now, Standings: standings}
+	return season, nil
+}
+
+func (li *leaderboardInteractor) GetSeason(ctx context.Context, number int) (*leaderboard.Season, error) {
+	season, err := li.seasonRepo.Find(ctx, number)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Find season: %w", err)
+	}
+
+	return season, nil
+}
@@ -0,0 +1,113 @@
+package interactors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/leaderboard"
+	mock_ports "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/ports"
+)
+
+func TestLeaderboardInteractor_CloseSeason(t *testing.T) {
+	const season = 3
+	standings := []leaderboard.Entry{
+		{Rank: 1, PlayerID: "player_1", Rating: 1720},
+		{Rank: 2, PlayerID: "player_2", Rating: 1610},
+	}
+
+	tests := []struct {
+		name     string
+		closeErr error
+		wantErr  func(err error) bool
+	}{
+		{name: "close"},
+		{name: "already closed", closeErr: exceptions.NewConflictError("conflict"), wantErr: exceptions.IsConflictError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			seasonRepo := mock_ports.NewMockSeasonRepository(ctrl)
+			seasonRepo.EXPECT().Current(gomock.Any()).Return(season, nil)
+			seasonRepo.EXPECT().Close(gomock.Any(), season, gomock.Any()).Return(tt.closeErr)
+			boardRepo := mock_ports.NewMockLeaderboardRepository(ctrl)
+
+			// 終了を記録してから順位を確定し、記録できなかった場合は順位表を残す
+			var saved []leaderboard.Entry
+			if tt.closeErr == nil {
+				gomock.InOrder(
+					boardRepo.EXPECT().List(gomock.Any(), season, leaderboard.BoardAllTime, gomock.Any(), 0, 0).Return(standings, nil),
+					seasonRepo.EXPECT().SaveStandings(gomock.Any(), season, gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ int, entries []leaderboard.Entry) error {
+							saved = entries
+							return nil
+						}),
+					boardRepo.EXPECT().Clear(gomock.Any(), season).Return(nil),
+				)
+			}
+
+			li := &leaderboardInteractor{leaderboardRepo: boardRepo, seasonRepo: seasonRepo}
+			got, err := li.CloseSeason(context.Background())
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to CloseSeason: %v", err)
+			}
+			if got.Number != season {
+				t.Fatalf("unexpected season: %+v", got)
+			}
+			for _, entries := range [][]leaderboard.Entry{saved, got.Standings} {
+				if diff := cmp.Diff(standings, entries); diff != "" {
+					t.Fatalf("(-want +got)\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLeaderboardInteractor_GetLeaderboard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	seasonRepo := mock_ports.NewMockSeasonRepository(ctrl)
+	seasonRepo.EXPECT().Current(gomock.Any()).Return(1, nil).AnyTimes()
+	boardRepo := mock_ports.NewMockLeaderboardRepository(ctrl)
+	gomock.InOrder(
+		boardRepo.EXPECT().List(gomock.Any(), 1, leaderboard.BoardWeekly, gomock.Any(), 0, 3).Return([]leaderboard.Entry{
+			{Rank: 1, PlayerID: "player_1"}, {Rank: 2, PlayerID: "player_2"}, {Rank: 3, PlayerID: "player_3"},
+		}, nil),
+		boardRepo.EXPECT().List(gomock.Any(), 1, leaderboard.BoardWeekly, gomock.Any(), 2, 3).Return([]leaderboard.Entry{
+			{Rank: 3, PlayerID: "player_3"},
+		}, nil),
+	)
+
+	li := &leaderboardInteractor{leaderboardRepo: boardRepo, seasonRepo: seasonRepo}
+	ctx := context.Background()
+
+	first, err := li.GetLeaderboard(ctx, leaderboard.BoardWeekly, "", 2)
+	if err != nil {
+		t.Fatalf("failed to GetLeaderboard: %v", err)
+	}
+	if len(first.Entries) != 2 || first.NextPageToken != "2" {
+		t.Fatalf("unexpected first page: %+v", first)
+	}
+	last, err := li.GetLeaderboard(ctx, leaderboard.BoardWeekly, first.NextPageToken, 2)
+	if err != nil {
+		t.Fatalf("failed to GetLeaderboard: %v", err)
+	}
+	if len(last.Entries) != 1 || last.NextPageToken != "" {
+		t.Fatalf("unexpected last page: %+v", last)
+	}
+
+	if _, err := li.GetLeaderboard(ctx, leaderboard.Board("monthly"), "", 2); !exceptions.IsInvalidArgumentError(err) {
+		t.Fatalf("wanted invalid argument error but got %v", err)
+	}
+}
@@ -2,9 +2,12 @@ package interactors
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/bot"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
 	"golang.org/x/xerrors"
 )
@@ -22,6 +25,10 @@ func NewLoginInteractor(rFactory ports.RepositoriesFactory) LoginInteractor {
 }
 
 func (li *loginInteractor) Login(ctx context.Context, login *tictactoe_battle.Login) (*tictactoe_battle.Login, error) {
+	if isReservedID(login.LoginId) {
+		return nil, exceptions.NewInvalidArgumentError(fmt.Sprintf("login id is reserved: %s", login.LoginId))
+	}
+
 	registeredLogin, err := li.loginRepo.FindByID(ctx, login.LoginId)
 
 	switch {
@@ -35,7 +42,11 @@ func (li *loginInteractor) Login(ctx context.Context, login *tictactoe_battle.Lo
 	case err != nil:
 		return nil, xerrors.Errorf("failed to FindByID: %w", err)
 
-	case login.SessionId != "" && login.SessionId != registeredLogin.SessionId:
+	// ログイン中のIDのsession idは、一致するsession idを指定した場合のみ返す
+	case login.SessionId == "":
+		return nil, exceptions.NewPreConditionError("login id is already in use")
+
+	case login.SessionId != registeredLogin.SessionId:
 		return nil, exceptions.NewPreConditionError("session id does not match")
 	}
 
@@ -47,8 +58,35 @@ func (li *loginInteractor) Login(ctx context.Context, login *tictactoe_battle.Lo
 }
 
 func (li *loginInteractor) Logout(ctx context.Context, login *tictactoe_battle.Login) error {
+	if err := li.Authenticate(ctx, login); err != nil {
+		return xerrors.Errorf("failed to Authenticate: %w", err)
+	}
 	if err := li.loginRepo.Logout(ctx, login.LoginId); err != nil {
 		return xerrors.Errorf("failed to Logout: %w", err)
 	}
 	return nil
 }
+
+func (li *loginInteractor) Authenticate(ctx context.Context, login *tictactoe_battle.Login) error {
+	if login.LoginId == "" || login.SessionId == "" {
+		return exceptions.NewUnauthenticatedError("login id and session id are required")
+	}
+
+	registeredLogin, err := li.loginRepo.FindByID(ctx, login.LoginId)
+	if exceptions.IsNotFoundError(err) {
+		return exceptions.NewUnauthenticatedError("not logged in")
+	}
+	if err != nil {
+		return xerrors.Errorf("failed to FindByID: %w", err)
+	}
+	if login.SessionId != registeredLogin.SessionId {
+		return exceptions.NewUnauthenticatedError("session id does not match")
+	}
+
+	return nil
+}
+
+// isReservedID botやプロセス内部の購読者が使用するため、ログインできないIDか.
+func isReservedID(loginID string) bool {
+	return bot.IsID(loginID) || strings.HasPrefix(loginID, internalIDPrefix)
+}
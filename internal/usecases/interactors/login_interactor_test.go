@@ -0,0 +1,178 @@
+package interactors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/bot"
+	mock_ports "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/ports"
+	"golang.org/x/xerrors"
+)
+
+func TestLoginInteractor_Login_ReservedID(t *testing.T) {
+	tests := []struct {
+		name    string
+		loginID string
+	}{
+		{name: "bot", loginID: bot.LevelEasy.ID()},
+		{name: "clock", loginID: clockID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// 予約されたIDは登録を確認する前に拒否する
+			li := &loginInteractor{loginRepo: mock_ports.NewMockLoginRepository(ctrl)}
+			_, err := li.Login(context.Background(), &tictactoe_battle.Login{LoginId: tt.loginID})
+			if !exceptions.IsInvalidArgumentError(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoginInteractor_Login(t *testing.T) {
+	registered := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+
+	tests := []struct {
+		name      string
+		login     *tictactoe_battle.Login
+		findErr   error
+		wantNew   bool
+		wantReLog bool
+		wantErr   bool
+		wantLogin *tictactoe_battle.Login
+	}{
+		{name: "new login", login: &tictactoe_battle.Login{LoginId: "player_a"},
+			findErr: exceptions.NewNotFoundError("not found"), wantNew: true, wantLogin: registered},
+		{name: "relogin", login: registered, wantReLog: true, wantLogin: registered},
+		{name: "already logged in", login: &tictactoe_battle.Login{LoginId: "player_a"}, wantErr: true},
+		{name: "incorrect session id", login: &tictactoe_battle.Login{LoginId: "player_a", SessionId: "other"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			loginRepo := mock_ports.NewMockLoginRepository(ctrl)
+			found := registered
+			if tt.findErr != nil {
+				found = nil
+			}
+			loginRepo.EXPECT().FindByID(gomock.Any(), tt.login.LoginId).Return(found, tt.findErr)
+			if tt.wantNew {
+				loginRepo.EXPECT().NewLogin(gomock.Any(), tt.login.LoginId).Return(registered, nil)
+			}
+			if tt.wantReLog {
+				loginRepo.EXPECT().ReLogin(gomock.Any(), tt.login).Return(nil)
+			}
+
+			li := &loginInteractor{loginRepo: loginRepo}
+			got, err := li.Login(context.Background(), tt.login)
+			if tt.wantErr {
+				// ログイン中のプレイヤーのsession idを返さない
+				if !exceptions.IsSessionMismatchError(err) || got != nil {
+					t.Fatalf("wanted SessionMismatchError without login but got (%v, %v)", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to Login: %v", err)
+			}
+			if got.LoginId != tt.wantLogin.LoginId || got.SessionId != tt.wantLogin.SessionId {
+				t.Fatalf("wanted %v but got %v", tt.wantLogin, got)
+			}
+		})
+	}
+}
+
+func TestLoginInteractor_Logout(t *testing.T) {
+	registered := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+
+	tests := []struct {
+		name       string
+		login      *tictactoe_battle.Login
+		wantFind   bool
+		wantLogout bool
+	}{
+		{name: "logout", login: registered, wantFind: true, wantLogout: true},
+		{name: "missing session id", login: &tictactoe_battle.Login{LoginId: "player_a"}},
+		{name: "incorrect session id", login: &tictactoe_battle.Login{LoginId: "player_a", SessionId: "other"}, wantFind: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			loginRepo := mock_ports.NewMockLoginRepository(ctrl)
+			if tt.wantFind {
+				loginRepo.EXPECT().FindByID(gomock.Any(), tt.login.LoginId).Return(registered, nil)
+			}
+			// 他のプレイヤーのsessionは削除しない
+			if tt.wantLogout {
+				loginRepo.EXPECT().Logout(gomock.Any(), tt.login.LoginId).Return(nil)
+			}
+
+			li := &loginInteractor{loginRepo: loginRepo}
+			err := li.Logout(context.Background(), tt.login)
+			if tt.wantLogout && err != nil {
+				t.Fatalf("failed to Logout: %v", err)
+			}
+			if !tt.wantLogout && !exceptions.IsUnauthenticatedError(err) {
+				t.Fatalf("wanted UnauthenticatedError but got %v", err)
+			}
+		})
+	}
+}
+
+func TestLoginInteractor_Authenticate(t *testing.T) {
+	registered := &tictactoe_battle.Login{LoginId: "player_a", SessionId: "session"}
+
+	tests := []struct {
+		name       string
+		login      *tictactoe_battle.Login
+		findErr    error
+		wantFind   bool
+		wantUnauth bool
+		wantErr    bool
+	}{
+		{name: "authenticated", login: registered, wantFind: true},
+		{name: "missing login id", login: &tictactoe_battle.Login{SessionId: "session"}, wantUnauth: true, wantErr: true},
+		{name: "missing session id", login: &tictactoe_battle.Login{LoginId: "player_a"}, wantUnauth: true, wantErr: true},
+		{name: "incorrect session id", login: &tictactoe_battle.Login{LoginId: "player_a", SessionId: "other"}, wantFind: true, wantUnauth: true, wantErr: true},
+		{name: "not logged in", login: registered, findErr: exceptions.NewNotFoundError("not found"), wantFind: true, wantUnauth: true, wantErr: true},
+		{name: "failed to find", login: registered, findErr: xerrors.New("unexpected"), wantFind: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			loginRepo := mock_ports.NewMockLoginRepository(ctrl)
+			if tt.wantFind {
+				found := registered
+				if tt.findErr != nil {
+					found = nil
+				}
+				loginRepo.EXPECT().FindByID(gomock.Any(), tt.login.LoginId).Return(found, tt.findErr)
+			}
+
+			li := &loginInteractor{loginRepo: loginRepo}
+			err := li.Authenticate(context.Background(), tt.login)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if exceptions.IsUnauthenticatedError(err) != tt.wantUnauth {
+				t.Fatalf("wanted unauthenticated %t but got %v", tt.wantUnauth, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"golang.org/x/xerrors"
+)
+
+const (
+	defaultMatchHistoryPageSize = 20
+	maxMatchHistoryPageSize     = 100
+	// streakPageSize 連勝数を数えるために1回に読み込むゲームの結果の数.
+	streakPageSize = 50
+)
+
+type (
+	playerInteractor struct {
+		archiveRepo ports.GameArchiveRepository
+		ratingRepo  ports.RatingRepository
+	}
+)
+
+func NewPlayerInteractor(rFactory ports.RepositoriesFactory) PlayerInteractor {
+	return &playerInteractor{
+		archiveRepo: rFactory.GameArchiveRepository(),
+		ratingRepo:  rFactory.RatingRepository(),
+	}
+}
+
+func (pi *playerInteractor) GetPlayerProfile(ctx context.Context, loginID string) (*archive.Profile, error) {
+	if loginID == "" {
+		return nil, exceptions.NewInvalidArgumentError("login id is required")
+	}
+
+	counts, err := pi.archiveRepo.CountOutcomes(ctx, loginID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to CountOutcomes: %w", err)
+	}
+	opening, err := pi.archiveRepo.FavoriteOpening(ctx, loginID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to FavoriteOpening: %w", err)
+	}
+	streak, err := pi.streak(ctx, loginID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to streak: %w", err)
+	}
+
+	return &archive.Profile{
+		PlayerID:        loginID,
+		Wins:            counts[archive.OutcomeWin],
+		Losses:          counts[archive.OutcomeLoss],
+		Draws:           counts[archive.OutcomeDraw],
+		Streak:          streak,
+		FavoriteOpening: opening,
+	}, nil
+}
+
+// streak loginIDの直近のゲームから同じ結果が続く数を返す. 結果が変わるまで新しい順に読み込む.
+func (pi *playerInteractor) streak(ctx context.Context, loginID string) (int, error) {
+	var outcomes []archive.Outcome
+	for {
+		page, err := pi.archiveRepo.ListOutcomes(ctx, loginID, len(outcomes), streakPageSize)
+		if err != nil {
+			return 0, xerrors.Errorf("failed to ListOutcomes: %w", err)
+		}
+		outcomes = append(outcomes, page...)
+
+		streak := archive.Streak(outcomes)
+		if len(page) < streakPageSize || streak != len(outcomes) && streak != -len(outcomes) {
+			return streak, nil
+		}
+	}
+}
+
+func (pi *playerInteractor) ListMatchHistory(ctx context.Context, loginID string, pageToken string, pageSize int) (*archive.MatchHistory, error) {
+	if loginID == "" {
+		return nil, exceptions.NewInvalidArgumentError("login id is required")
+	}
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultMatchHistoryPageSize
+	case pageSize > maxMatchHistoryPageSize:
+		pageSize = maxMatchHistoryPageSize
+	}
+
+	// pageTokenは次のページの先頭の件数
+	offset := 0
+	if pageToken != "" {
+		var err error
+		if offset, err = strconv.Atoi(pageToken); err != nil || offset < 0 {
+			return nil, exceptions.NewInvalidArgumentError(fmt.Sprintf("invalid page token: %s", pageToken))
+		}
+	}
+
+	// 次のページの有無を判定するため1件多く取得する
+	games, err := pi.archiveRepo.ListByPlayer(ctx, loginID, offset, pageSize+1)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ListByPlayer: %w", err)
+	}
+
+	ret := &archive.MatchHistory{Matches: make([]archive.Match, 0, pageSize)}
+	if len(games) > pageSize {
+		games = games[:pageSize]
+		ret.NextPageToken = strconv.Itoa(offset + pageSize)
+	}
+	for _, g := range games {
+		if m, ok := g.MatchOf(loginID); ok {
+			ret.Matches = append(ret.Matches, m)
+		}
+	}
+
+	return ret, nil
+}
+
+func (pi *playerInteractor) GetRating(ctx context.Context, loginID string) (*rating.Rating, error) {
+	if loginID == "" {
+		return nil, exceptions.NewInvalidArgumentError("login id is required")
+	}
+
+	r, err := pi.ratingRepo.Find(ctx, loginID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Find: %w", err)
+	}
+
+	decayed := r.Decayed(time.Now())
+	return &decayed, nil
+}
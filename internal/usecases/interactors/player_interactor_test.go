@@ -0,0 +1,138 @@
+package interactors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	mock_ports "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/ports"
+)
+
+func TestPlayerInteractor_GetPlayerProfile(t *testing.T) {
+	const me = "me"
+
+	// repeat outcomeをn回繰り返す.
+	repeat := func(outcome archive.Outcome, n int) []archive.Outcome {
+		ret := make([]archive.Outcome, n)
+		for i := range ret {
+			ret[i] = outcome
+		}
+		return ret
+	}
+
+	tests := []struct {
+		name       string
+		outcomes   []archive.Outcome
+		wantStreak int
+		wantPages  int
+	}{
+		{name: "no games", wantPages: 1},
+		{name: "draw", outcomes: []archive.Outcome{archive.OutcomeDraw, archive.OutcomeWin}, wantPages: 1},
+		{name: "streak ends in first page", outcomes: append(repeat(archive.OutcomeLoss, 3), repeat(archive.OutcomeWin, streakPageSize)...), wantStreak: -3, wantPages: 1},
+		{name: "streak over pages", outcomes: append(repeat(archive.OutcomeWin, streakPageSize+1), archive.OutcomeDraw), wantStreak: streakPageSize + 1, wantPages: 2},
+		{name: "all games in streak", outcomes: repeat(archive.OutcomeWin, streakPageSize), wantStreak: streakPageSize, wantPages: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			counts := map[archive.Outcome]int{archive.OutcomeWin: 3, archive.OutcomeLoss: 2, archive.OutcomeDraw: 1}
+			archiveRepo := mock_ports.NewMockGameArchiveRepository(ctrl)
+			archiveRepo.EXPECT().CountOutcomes(gomock.Any(), me).Return(counts, nil)
+			archiveRepo.EXPECT().FavoriteOpening(gomock.Any(), me).Return(tictactoe_battle.Position_POSITION_X1Y1, nil)
+			archiveRepo.EXPECT().ListOutcomes(gomock.Any(), me, gomock.Any(), streakPageSize).DoAndReturn(
+				func(_ context.Context, _ string, offset, limit int) ([]archive.Outcome, error) {
+					if offset >= len(tt.outcomes) {
+						return nil, nil
+					}
+					end := offset + limit
+					if end > len(tt.outcomes) {
+						end = len(tt.outcomes)
+					}
+					return tt.outcomes[offset:end], nil
+				}).Times(tt.wantPages)
+
+			pi := &playerInteractor{archiveRepo: archiveRepo}
+			got, err := pi.GetPlayerProfile(context.Background(), me)
+			if err != nil {
+				t.Fatalf("failed to GetPlayerProfile: %v", err)
+			}
+			want := &archive.Profile{
+				PlayerID:        me,
+				Wins:            3,
+				Losses:          2,
+				Draws:           1,
+				Streak:          tt.wantStreak,
+				FavoriteOpening: tictactoe_battle.Position_POSITION_X1Y1,
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Fatalf("(-want +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlayerInteractor_ListMatchHistory(t *testing.T) {
+	const me = "me"
+
+	games := []*archive.Game{
+		{GameID: battle.GameID("1-a"), PlayerAID: me, PlayerBID: "rival_1", Result: archive.ResultPlayerAWin},
+		{GameID: battle.GameID("1-b"), PlayerAID: "rival_2", PlayerBID: me, Result: archive.ResultPlayerAWin},
+		{GameID: battle.GameID("1-c"), PlayerAID: me, PlayerBID: "rival_3", Result: archive.ResultDraw},
+	}
+	listByPlayer := func(_ context.Context, _ string, offset, limit int) ([]*archive.Game, error) {
+		if offset >= len(games) {
+			return nil, nil
+		}
+		end := offset + limit
+		if end > len(games) {
+			end = len(games)
+		}
+		return games[offset:end], nil
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	archiveRepo := mock_ports.NewMockGameArchiveRepository(ctrl)
+	archiveRepo.EXPECT().ListByPlayer(gomock.Any(), me, gomock.Any(), gomock.Any()).DoAndReturn(listByPlayer).AnyTimes()
+	pi := &playerInteractor{archiveRepo: archiveRepo}
+	ctx := context.Background()
+
+	var (
+		token     string
+		opponents []string
+		outcomes  []archive.Outcome
+	)
+	for page := 0; page == 0 || token != ""; page++ {
+		if page > len(games) {
+			t.Fatalf("too many pages")
+		}
+		history, err := pi.ListMatchHistory(ctx, me, token, 2)
+		if err != nil {
+			t.Fatalf("failed to ListMatchHistory: %v", err)
+		}
+		for _, m := range history.Matches {
+			opponents = append(opponents, m.OpponentID)
+			outcomes = append(outcomes, m.Outcome)
+		}
+		token = history.NextPageToken
+	}
+
+	if diff := cmp.Diff([]string{"rival_1", "rival_2", "rival_3"}, opponents); diff != "" {
+		t.Fatalf("(-want +got)\n%s", diff)
+	}
+	if diff := cmp.Diff([]archive.Outcome{archive.OutcomeWin, archive.OutcomeLoss, archive.OutcomeDraw}, outcomes); diff != "" {
+		t.Fatalf("(-want +got)\n%s", diff)
+	}
+
+	if _, err := pi.ListMatchHistory(ctx, me, "broken", 2); !exceptions.IsInvalidArgumentError(err) {
+		t.Fatalf("wanted invalid argument error but got %v", err)
+	}
+}
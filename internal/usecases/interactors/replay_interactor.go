@@ -0,0 +1,117 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/listener"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"golang.org/x/xerrors"
+)
+
+type (
+	replayInteractor struct {
+		battleRule battle.Rule
+		battleRepo ports.BattleRepository
+		replayRepo ports.ReplayRepository
+	}
+)
+
+func NewReplayInteractor(dFactory domains.Factory, rFactory ports.RepositoriesFactory) ReplayInteractor {
+	return &replayInteractor{
+		battleRule: dFactory.BattleRule(),
+		battleRepo: rFactory.BattleRepository(),
+		replayRepo: rFactory.ReplayRepository(),
+	}
+}
+
+func (ri *replayInteractor) GetReplay(ctx context.Context, gameID battle.GameID, loginID string) ([]*tictactoe_battle_ext.BattleSituation, error) {
+	frames, err := ri.frames(ctx, gameID, loginID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to frames: %w", err)
+	}
+
+	situations := make([]*tictactoe_battle_ext.BattleSituation, 0, len(frames))
+	for _, f := range frames {
+		situations = append(situations, f.Situation)
+	}
+
+	return situations, nil
+}
+
+func (ri *replayInteractor) StreamReplay(ctx context.Context, gameID battle.GameID, loginID string, speed float64) (ports.BattleListener, error) {
+	if speed <= 0 {
+		return nil, exceptions.NewInvalidArgumentError(fmt.Sprintf("playback speed must be positive: %f", speed))
+	}
+
+	frames, err := ri.frames(ctx, gameID, loginID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to frames: %w", err)
+	}
+
+	return listener.NewReplayListener(frames, speed), nil
+}
+
+// frames ゲームのmoveを先頭から適用し、moveごとの局面をloginIDの視点で返す.
+func (ri *replayInteractor) frames(ctx context.Context, gameID battle.GameID, loginID string) ([]listener.ReplayFrame, error) {
+	moves, err := ri.moves(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	b := ri.battleRule.OpenBattle(battle.DefaultRuleSet())
+	b.RoomID = gameID.RoomID()
+	b.GameID = gameID
+
+	frames := make([]listener.ReplayFrame, 0, len(moves))
+	for i, m := range moves {
+		if err := battle.Apply(ri.battleRule, b, m); err != nil {
+			return nil, xerrors.Errorf("failed to Apply move %d: %w", i, err)
+		}
+
+		bs, err := listener.Situation(b.Clone(), loginID)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to Situation: %w", err)
+		}
+		frames = append(frames, listener.ReplayFrame{Situation: bs, PlayedAt: m.PlayedAt})
+	}
+
+	return frames, nil
+}
+
+// moves 保存済みのゲームを優先し、見つからない場合は進行中のroomから取得する.
+func (ri *replayInteractor) moves(ctx context.Context, gameID battle.GameID) ([]*battle.Move, error) {
+	moves, err := ri.replayRepo.FindMoves(ctx, gameID)
+	if err == nil {
+		return moves, nil
+	}
+	if !exceptions.IsNotFoundError(err) {
+		return nil, xerrors.Errorf("failed to FindMoves: %w", err)
+	}
+
+	roomMoves, err := ri.battleRepo.ListMoves(ctx, gameID.RoomID())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ListMoves: %w", err)
+	}
+	moves = gameMoves(roomMoves, gameID)
+	if len(moves) == 0 {
+		return nil, exceptions.NewNotFoundError(fmt.Sprintf("game %s does not exist", gameID))
+	}
+
+	return moves, nil
+}
+
+// gameMoves room内のmoveからgameIDのゲームのものを抽出する.
+func gameMoves(moves []*battle.Move, gameID battle.GameID) []*battle.Move {
+	ret := make([]*battle.Move, 0, len(moves))
+	for _, m := range moves {
+		if m.GameID == gameID {
+			ret = append(ret, m)
+		}
+	}
+	return ret
+}
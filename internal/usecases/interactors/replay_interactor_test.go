@@ -0,0 +1,112 @@
+package interactors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	mock_ports "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/ports"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/listener"
+	"golang.org/x/xerrors"
+)
+
+func TestReplayInteractor(t *testing.T) {
+	const (
+		gameID    = battle.GameID("12345-abcdefgh")
+		prevGame  = battle.GameID("12345-zyxwvuts")
+		playerA   = "player_a"
+		playerB   = "player_b"
+		frameSpan = time.Second
+	)
+
+	playedAt := time.Now()
+	newMove := func(m *battle.Move, id battle.GameID) *battle.Move {
+		m.GameID = id
+		m.PlayedAt = playedAt
+		playedAt = playedAt.Add(frameSpan)
+		return m
+	}
+	roomMoves := []*battle.Move{
+		newMove(battle.NewDeclaration("previous"), prevGame),
+		newMove(battle.NewReset(battle.DefaultRuleSet(), battle.TimeControl{}, false), gameID),
+		newMove(battle.NewDeclaration(playerA), gameID),
+		newMove(battle.NewDeclaration(playerB), gameID),
+		newMove(battle.NewAttack(tictactoe_battle.Player_PLAYER_A, tictactoe_battle.Position_POSITION_X1Y1, tictactoe_battle.Piece_PIECE_L), gameID),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	replayRepo := mock_ports.NewMockReplayRepository(ctrl)
+	replayRepo.EXPECT().FindMoves(gomock.Any(), gameID).Return(nil, exceptions.NewNotFoundError("not found")).AnyTimes()
+	battleRepo := mock_ports.NewMockBattleRepository(ctrl)
+	battleRepo.EXPECT().ListMoves(gomock.Any(), gameID.RoomID()).Return(roomMoves, nil).AnyTimes()
+
+	ri := &replayInteractor{
+		battleRule: battle.NewRule(),
+		battleRepo: battleRepo,
+		replayRepo: replayRepo,
+	}
+	ctx := context.Background()
+
+	t.Run("GetReplay", func(t *testing.T) {
+		situations, err := ri.GetReplay(ctx, gameID, playerB)
+		if err != nil {
+			t.Fatalf("failed to GetReplay: %v", err)
+		}
+		if len(situations) != 4 {
+			t.Fatalf("wanted 4 frames but got %d", len(situations))
+		}
+		if s := situations[0].Situation; s.PlayerAId != "" || s.State != tictactoe_battle.BattleState_BATTLE_STATE_MEETING {
+			t.Fatalf("unexpected first frame: %v", s)
+		}
+		last := situations[len(situations)-1].Situation
+		if last.Player != tictactoe_battle.Player_PLAYER_B || last.State != tictactoe_battle.BattleState_BATTLE_STATE_PLAYER_TURN {
+			t.Fatalf("unexpected last frame: %v", last)
+		}
+		if last.Field[tictactoe_battle.Position_POSITION_X1Y1].L != tictactoe_battle.Player_PLAYER_A {
+			t.Fatalf("unexpected field: %v", last.Field)
+		}
+		if situations[2].Situation.Field[tictactoe_battle.Position_POSITION_X1Y1].L != tictactoe_battle.Player_PLAYER_UNKNOWN {
+			t.Fatalf("frames must not share the field: %v", situations[2].Situation.Field)
+		}
+	})
+
+	t.Run("StreamReplay", func(t *testing.T) {
+		if _, err := ri.StreamReplay(ctx, gameID, playerA, 0); !exceptions.IsInvalidArgumentError(err) {
+			t.Fatalf("wanted InvalidArgumentError but got %v", err)
+		}
+
+		const speed = 100
+		lsnr, err := ri.StreamReplay(ctx, gameID, playerA, speed)
+		if err != nil {
+			t.Fatalf("failed to StreamReplay: %v", err)
+		}
+
+		started := time.Now()
+		for i := 0; i < 4; i++ {
+			if _, err := lsnr.Listen(ctx); err != nil {
+				t.Fatalf("failed to Listen: %v", err)
+			}
+		}
+		if elapsed, want := time.Since(started), 3*frameSpan/speed; elapsed < want {
+			t.Fatalf("wanted at least %s but got %s", want, elapsed)
+		}
+		if _, err := lsnr.Listen(ctx); !xerrors.Is(err, listener.ReplayEndedError) {
+			t.Fatalf("wanted ReplayEndedError but got %v", err)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		const unknown = battle.GameID("12345-unknown0")
+		replayRepo.EXPECT().FindMoves(gomock.Any(), unknown).Return(nil, exceptions.NewNotFoundError("not found"))
+
+		if _, err := ri.GetReplay(ctx, unknown, playerA); !exceptions.IsNotFoundError(err) {
+			t.Fatalf("wanted NotFoundError but got %v", err)
+		}
+	})
+}
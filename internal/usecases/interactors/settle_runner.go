@@ -0,0 +1,200 @@
+package interactors
+
+import (
+	"context"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/exceptions"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// settleAttempts 終了したゲームの保存の最大試行回数. 反映できなかったratingはReconcileRatingsで反映する.
+	settleAttempts = 5
+	// defaultSettleBackoff 保存に失敗した場合の最初の再試行までの間隔. 試行ごとに倍にする.
+	defaultSettleBackoff = time.Second
+	// reconcileBatchSize ReconcileRatingsの1回の呼び出しで反映するゲームの最大数.
+	reconcileBatchSize = 100
+)
+
+var (
+	// errNextGameStarted 終了したゲームへの記録の前に次のゲームが始まっている.
+	errNextGameStarted = xerrors.New("next game has already started")
+	// errAlreadyRated 終了したゲームのratingの変化が既に配信されている.
+	errAlreadyRated = xerrors.New("rating change has already been published")
+)
+
+// settle 終了したbのゲームをリクエストとは別に保存する. 失敗した場合は間隔を空けて再試行する.
+//   保存の各手順は冪等であり、再試行やReconcileRatingsと重複して実行しても結果は変わらない.
+func (bi *battleInteractor) settle(ctx context.Context, b *battle.Battle) {
+	logger := loggers.Logger(ctx).With(zap.String("game_id", b.GameID.String()))
+	settleCtx := loggers.LoggerToContext(context.Background(), logger)
+	b = b.Clone()
+
+	bi.settling.Add(1)
+	go func() {
+		defer bi.settling.Done()
+
+		backoff := bi.settleBackoff
+		for i := 1; ; i++ {
+			err := bi.saveFinished(settleCtx, b)
+			if err == nil {
+				return
+			}
+			if i >= settleAttempts {
+				logger.Error("gave up saving the finished game", zap.Error(err))
+				return
+			}
+			logger.Warn("failed to saveFinished", zap.Int("attempt", i), zap.Error(err))
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}()
+}
+
+// saveFinished 終了したゲームのmoveと結果をroomの削除後も参照できるよう保存する.
+//   ranked roomのゲームはratingに反映し、変化をMoveRatedとして記録して最終局面として両プレイヤーに配信する.
+func (bi *battleInteractor) saveFinished(ctx context.Context, b *battle.Battle) error {
+	roomMoves, err := bi.battleRepo.ListMoves(ctx, b.RoomID)
+	if err != nil {
+		return xerrors.Errorf("failed to ListMoves: %w", err)
+	}
+	moves := gameMoves(roomMoves, b.GameID)
+
+	if err := bi.replayRepo.Save(ctx, b.GameID, moves); err != nil {
+		return xerrors.Errorf("failed to Save replay: %w", err)
+	}
+	game := archive.NewGame(b, moves)
+	if err := bi.archiveRepo.Save(ctx, game); err != nil {
+		return xerrors.Errorf("failed to Save archive: %w", err)
+	}
+	if !game.Ranked {
+		return nil
+	}
+
+	result, err := bi.rate(ctx, game)
+	if err != nil {
+		return xerrors.Errorf("failed to rate: %w", err)
+	}
+
+	// 既に次のゲームが始まっている場合やroomが削除されている場合はratingの保存のみとする
+	if err := bi.update(ctx, b.RoomID, func(cur *battle.Battle) (*battle.Move, error) {
+		if cur.GameID != b.GameID {
+			return nil, errNextGameStarted
+		}
+		if cur.Ratings != nil {
+			return nil, errAlreadyRated
+		}
+		return battle.NewRated(*result), nil
+	}); err != nil && !xerrors.Is(err, errNextGameStarted) && !xerrors.Is(err, errAlreadyRated) && !exceptions.IsNotFoundError(err) {
+		return xerrors.Errorf("failed to update: %w", err)
+	}
+
+	return nil
+}
+
+// rate ranked roomで終了したgameの結果から両プレイヤーのratingを更新し、順位表に記録する.
+//   ratingはgameのGameIDと共に1つのtransactionで保存するため、同じゲームを再度rateしても保存済みの変化を返す.
+func (bi *battleInteractor) rate(ctx context.Context, game *archive.Game) (*rating.Result, error) {
+	score := 0.5
+	switch game.Result {
+	case archive.ResultPlayerAWin:
+		score = 1
+	case archive.ResultPlayerBWin:
+		score = 0
+	}
+
+	var result *rating.Result
+	for i := 1; ; i++ {
+		rated, err := bi.ratingRepo.FindResult(ctx, game.GameID)
+		if err == nil {
+			result = rated
+			break
+		}
+		if !exceptions.IsNotFoundError(err) {
+			return nil, xerrors.Errorf("failed to FindResult: %w", err)
+		}
+
+		a, err := bi.ratingRepo.Find(ctx, game.PlayerAID)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to Find player a rating: %w", err)
+		}
+		b, err := bi.ratingRepo.Find(ctx, game.PlayerBID)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to Find player b rating: %w", err)
+		}
+
+		newA, newB := rating.Update(*a, *b, score, time.Now())
+		changes := &rating.Result{
+			PlayerA: rating.Change{Before: a.Rating, After: newA.Rating},
+			PlayerB: rating.Change{Before: b.Rating, After: newB.Rating},
+		}
+		err = bi.ratingRepo.Save(ctx, game.GameID, changes, &newA, &newB)
+		if err == nil {
+			result = changes
+			break
+		}
+		// 同じプレイヤーの別の対局と同時に終了した場合や、同じゲームが同時に反映された場合は読み込みからやり直す
+		if !exceptions.IsConflictError(err) || i >= maxUpdateAttempts {
+			return nil, xerrors.Errorf("failed to Save rating: %w", err)
+		}
+	}
+
+	if err := bi.recordLeaderboard(ctx, game.PlayerAID, game.PlayerBID); err != nil {
+		return nil, xerrors.Errorf("failed to recordLeaderboard: %w", err)
+	}
+
+	return result, nil
+}
+
+// recordLeaderboard playerIDsの現在のratingを進行中のseasonの順位表に記録する.
+//   記録するのは保存済みのratingのため、再試行や反映の順序が前後しても最新のratingとなる.
+func (bi *battleInteractor) recordLeaderboard(ctx context.Context, playerIDs ...string) error {
+	season, err := bi.seasonRepo.Current(ctx)
+	if err != nil {
+		return xerrors.Errorf("failed to Current season: %w", err)
+	}
+
+	for {
+		for _, playerID := range playerIDs {
+			r, err := bi.ratingRepo.Find(ctx, playerID)
+			if err != nil {
+				return xerrors.Errorf("failed to Find rating: %w", err)
+			}
+			if err := bi.boardRepo.Record(ctx, season, playerID, r.Rating, r.UpdatedAt); err != nil {
+				return xerrors.Errorf("failed to Record leaderboard: %w", err)
+			}
+		}
+
+		// 記録の間にseasonが終了した場合は終了時の順位に含まれないことがあるため、次のseasonにも記録する
+		current, err := bi.seasonRepo.Current(ctx)
+		if err != nil {
+			return xerrors.Errorf("failed to Current season: %w", err)
+		}
+		if current == season {
+			return nil
+		}
+		season = current
+	}
+}
+
+func (bi *battleInteractor) ReconcileRatings(ctx context.Context) error {
+	games, err := bi.archiveRepo.ListUnrated(ctx, reconcileBatchSize)
+	if err != nil {
+		return xerrors.Errorf("failed to ListUnrated: %w", err)
+	}
+
+	for _, game := range games {
+		if _, err := bi.rate(ctx, game); err != nil {
+			return xerrors.Errorf("failed to rate %s: %w", game.GameID, err)
+		}
+		loggers.Logger(ctx).Info("reconciled rating", zap.String("game_id", game.GameID.String()))
+	}
+
+	return nil
+}
@@ -2,72 +2,70 @@ package listener
 
 import (
 	"context"
+	"time"
 
 	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
-	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
 	"golang.org/x/xerrors"
 )
 
 type (
 	battleListener struct {
-		roomID        room.ID
-		loginID       string
-		battleRepo    ports.BattleRepository
-		lastMessageID string
+		loginID string
+		sub     *subscriber
 	}
 )
 
 var LeftError = xerrors.New("already left the room")
 
-func NewBattleListener(roomID room.ID, loginID string, battleRepo ports.BattleRepository) ports.BattleListener {
+func newBattleListener(loginID string, sub *subscriber) ports.BattleListener {
 	return &battleListener{
-		roomID:     roomID,
-		loginID:    loginID,
-		battleRepo: battleRepo,
+		loginID: loginID,
+		sub:     sub,
 	}
 }
 
-func (l *battleListener) Listen(ctx context.Context) (*tictactoe_battle.BattleSituation, error) {
-	var (
-		newMsgID string
-		bt       *battle.Battle
-		err      error
-	)
-
-	isExists, err := l.battleRepo.IsExistsInRoom(ctx, l.roomID, l.loginID)
-	if err != nil {
-		return nil, xerrors.Errorf("failed to IsExistsInRoom: %w", err)
-	}
-	if !isExists {
+// Listen battleが更新されるまで待機する. roomから退出した場合はLeftErrorを返す.
+func (l *battleListener) Listen(ctx context.Context) (*tictactoe_battle_ext.BattleSituation, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-l.sub.left:
 		return nil, LeftError
+	case bt := <-l.sub.battles:
+		return Situation(bt, l.loginID)
 	}
+}
 
-	if l.lastMessageID == "" {
-		newMsgID, bt, err = l.battleRepo.ReadStreamLatest(ctx, l.roomID)
-		if err != nil {
-			return nil, xerrors.Errorf("failed to ReadStreamLatest: %w", err)
-		}
-	} else {
-		newMsgID, bt, err = l.battleRepo.ReadStream(ctx, l.roomID, l.lastMessageID)
-		if err != nil {
-			return nil, xerrors.Errorf("failed to ReadStream: %w", err)
-		}
-	}
-
-	l.lastMessageID = newMsgID
-
-	ret, err := l.convert(bt)
+// Situation loginIDの視点から見たbattleの状況に変換する. 席についていない場合は観戦者の視点.
+func Situation(b *battle.Battle, loginID string) (*tictactoe_battle_ext.BattleSituation, error) {
+	bs, err := situation(b, loginID)
 	if err != nil {
 		return nil, err
 	}
 
+	ret := &tictactoe_battle_ext.BattleSituation{
+		Situation:          bs,
+		Clock:              clockOf(b.Clock),
+		RematchRequestedBy: b.RematchRequestedBy,
+		ResetRequestedBy:   b.ResetRequestedBy,
+		Ratings:            ratingsOf(b.Ratings),
+		Status:             statusOf(b.State, b.PlayerOf(loginID)),
+		Series: &tictactoe_battle_ext.Series{
+			PlayerAWins: int32(b.Series.PlayerAWins),
+			PlayerBWins: int32(b.Series.PlayerBWins),
+			Draws:       int32(b.Series.Draws),
+		},
+	}
 	return ret, nil
 }
 
-func (l *battleListener) convert(b *battle.Battle) (*tictactoe_battle.BattleSituation, error) {
+// situation loginIDの視点から見たtictactoe-battle-protoのBattleSituationに変換する.
+func situation(b *battle.Battle, loginID string) (*tictactoe_battle.BattleSituation, error) {
 	ret := &tictactoe_battle.BattleSituation{
 		RoomId:         b.RoomID.String(),
 		PlayerAId:      b.PlayerAID,
@@ -78,8 +76,9 @@ func (l *battleListener) convert(b *battle.Battle) (*tictactoe_battle.BattleSitu
 		WinLine:        b.WinLine,
 	}
 
-	switch l.loginID {
-	case b.PlayerBID:
+	player := b.PlayerOf(loginID)
+	switch player {
+	case tictactoe_battle.Player_PLAYER_B:
 		ret.Player = tictactoe_battle.Player_PLAYER_B
 		ret.Holding = b.PlayerBHolding
 
@@ -88,14 +87,21 @@ func (l *battleListener) convert(b *battle.Battle) (*tictactoe_battle.BattleSitu
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_OPPONENT_TURN
 		case management_state.PlayerAPicked:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_OPPONENT_TURN_PICKED
-		case management_state.PlayerAWin:
+		case management_state.PlayerAWin, management_state.PlayerBResigned:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_LOSE
+		case management_state.PlayerADrawOffered:
+			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_OPPONENT_TURN
 		case management_state.PlayerBTurn:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_PLAYER_TURN
 		case management_state.PlayerBPicked:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_PLAYER_TURN_PICKED
-		case management_state.PlayerBWin:
+		case management_state.PlayerBDrawOffered:
+			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_OPPONENT_TURN
+		case management_state.PlayerBWin, management_state.PlayerAResigned:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_WIN
+		case management_state.Draw, management_state.DrawAgreed:
+			// 引き分けはBattleStateに無いため、statusで表す
+			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_UNKNOWN
 		case management_state.Meeting:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_MEETING
 		case management_state.Error:
@@ -104,7 +110,7 @@ func (l *battleListener) convert(b *battle.Battle) (*tictactoe_battle.BattleSitu
 			return nil, xerrors.Errorf("unexpected management state: %s", b.State)
 		}
 	default:
-		if l.loginID == b.PlayerAID {
+		if player == tictactoe_battle.Player_PLAYER_A {
 			ret.Player = tictactoe_battle.Player_PLAYER_A
 			ret.Holding = b.PlayerAHolding
 		} else {
@@ -117,14 +123,21 @@ func (l *battleListener) convert(b *battle.Battle) (*tictactoe_battle.BattleSitu
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_PLAYER_TURN
 		case management_state.PlayerAPicked:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_PLAYER_TURN_PICKED
-		case management_state.PlayerAWin:
+		case management_state.PlayerAWin, management_state.PlayerBResigned:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_WIN
+		case management_state.PlayerADrawOffered:
+			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_OPPONENT_TURN
 		case management_state.PlayerBTurn:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_OPPONENT_TURN
 		case management_state.PlayerBPicked:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_OPPONENT_TURN_PICKED
-		case management_state.PlayerBWin:
+		case management_state.PlayerBDrawOffered:
+			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_OPPONENT_TURN
+		case management_state.PlayerBWin, management_state.PlayerAResigned:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_LOSE
+		case management_state.Draw, management_state.DrawAgreed:
+			// 引き分けはBattleStateに無いため、statusで表す
+			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_UNKNOWN
 		case management_state.Meeting:
 			ret.State = tictactoe_battle.BattleState_BATTLE_STATE_MEETING
 		case management_state.Error:
@@ -136,3 +149,58 @@ func (l *battleListener) convert(b *battle.Battle) (*tictactoe_battle.BattleSitu
 
 	return ret, nil
 }
+
+// statusOf playerの視点から見たBattleStateで表せない対局の状態を返す.
+func statusOf(s management_state.State, player tictactoe_battle.Player) tictactoe_battle_ext.GameStatus {
+	var offeredTo tictactoe_battle.Player
+	switch s {
+	case management_state.Draw, management_state.DrawAgreed:
+		return tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW
+	case management_state.PlayerADrawOffered:
+		offeredTo = tictactoe_battle.Player_PLAYER_B
+	case management_state.PlayerBDrawOffered:
+		offeredTo = tictactoe_battle.Player_PLAYER_A
+	default:
+		return tictactoe_battle_ext.GameStatus_GAME_STATUS_UNDEFINED
+	}
+
+	if player == offeredTo {
+		return tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW_OFFERED
+	}
+	return tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW_OFFER_PENDING
+}
+
+// clockOf 持ち時間を変換する. 持ち時間が無い場合はnil.
+func clockOf(c *battle.Clock) *tictactoe_battle_ext.Clock {
+	if c == nil {
+		return nil
+	}
+
+	return &tictactoe_battle_ext.Clock{
+		PlayerARemainingMs: c.PlayerARemaining.Milliseconds(),
+		PlayerBRemainingMs: c.PlayerBRemaining.Milliseconds(),
+		TurnStartedAtMs:    turnStartedAtMs(c),
+	}
+}
+
+// ratingsOf 変化後のratingと増減を変換する. 記録されていない場合はnil.
+func ratingsOf(r *rating.Result) *tictactoe_battle_ext.Ratings {
+	if r == nil {
+		return nil
+	}
+
+	return &tictactoe_battle_ext.Ratings{
+		PlayerARating:       r.PlayerA.After,
+		PlayerARatingChange: r.PlayerA.Delta(),
+		PlayerBRating:       r.PlayerB.After,
+		PlayerBRatingChange: r.PlayerB.Delta(),
+	}
+}
+
+// turnStartedAtMs 手番の開始時刻のunix時間(ms). 時計が止まっている場合は0.
+func turnStartedAtMs(c *battle.Clock) int64 {
+	if !c.IsRunning() {
+		return 0
+	}
+	return c.TurnStartedAt.UnixNano() / int64(time.Millisecond)
+}
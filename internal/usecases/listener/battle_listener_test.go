@@ -0,0 +1,164 @@
+package listener
+
+import (
+	"testing"
+	"time"
+
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/management_state"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSituation_Clock(t *testing.T) {
+	started := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	b := battle.NewRule().OpenBattle(battle.DefaultRuleSet())
+	b.Clock = &battle.Clock{
+		PlayerARemaining: 90 * time.Second,
+		PlayerBRemaining: 30 * time.Second,
+		TurnStartedAt:    started,
+	}
+
+	bs, err := Situation(b, "player_a")
+	if err != nil {
+		t.Fatalf("failed to Situation: %v", err)
+	}
+
+	want := &tictactoe_battle_ext.Clock{
+		PlayerARemainingMs: 90000,
+		PlayerBRemainingMs: 30000,
+		TurnStartedAtMs:    started.UnixNano() / int64(time.Millisecond),
+	}
+	if !proto.Equal(bs.Clock, want) {
+		t.Fatalf("wanted %v but got %v", want, bs.Clock)
+	}
+}
+
+func TestSituation_Series(t *testing.T) {
+	b := battle.NewRule().OpenBattle(battle.DefaultRuleSet())
+	b.Series = battle.Series{PlayerAWins: 2, PlayerBWins: 1}
+	b.RematchRequestedBy = tictactoe_battle.Player_PLAYER_B
+
+	bs, err := Situation(b, "player_a")
+	if err != nil {
+		t.Fatalf("failed to Situation: %v", err)
+	}
+
+	want := &tictactoe_battle_ext.Series{PlayerAWins: 2, PlayerBWins: 1}
+	if !proto.Equal(bs.Series, want) {
+		t.Fatalf("wanted %v but got %v", want, bs.Series)
+	}
+	if bs.RematchRequestedBy != tictactoe_battle.Player_PLAYER_B {
+		t.Fatalf("wanted %s but got %s", tictactoe_battle.Player_PLAYER_B, bs.RematchRequestedBy)
+	}
+}
+
+func TestSituation_ResetRequestedBy(t *testing.T) {
+	b := battle.NewRule().OpenBattle(battle.DefaultRuleSet())
+	b.ResetRequestedBy = tictactoe_battle.Player_PLAYER_A
+
+	bs, err := Situation(b, "player_b")
+	if err != nil {
+		t.Fatalf("failed to Situation: %v", err)
+	}
+	if bs.ResetRequestedBy != tictactoe_battle.Player_PLAYER_A {
+		t.Fatalf("wanted %s but got %s", tictactoe_battle.Player_PLAYER_A, bs.ResetRequestedBy)
+	}
+}
+
+func TestSituation_Ratings(t *testing.T) {
+	b := battle.NewRule().OpenBattle(battle.DefaultRuleSet())
+	b.Ratings = &rating.Result{
+		PlayerA: rating.Change{Before: 1500, After: 1662.5},
+		PlayerB: rating.Change{Before: 1500, After: 1337.5},
+	}
+
+	bs, err := Situation(b, "player_b")
+	if err != nil {
+		t.Fatalf("failed to Situation: %v", err)
+	}
+
+	want := &tictactoe_battle_ext.Ratings{
+		PlayerARating:       1662.5,
+		PlayerARatingChange: 162.5,
+		PlayerBRating:       1337.5,
+		PlayerBRatingChange: -162.5,
+	}
+	if !proto.Equal(bs.Ratings, want) {
+		t.Fatalf("wanted %v but got %v", want, bs.Ratings)
+	}
+}
+
+func TestSituation_NoExtension(t *testing.T) {
+	b := battle.NewRule().OpenBattle(battle.DefaultRuleSet())
+
+	bs, err := Situation(b, "player_a")
+	if err != nil {
+		t.Fatalf("failed to Situation: %v", err)
+	}
+
+	// tictactoe-battle-protoのBattleSituationには未定義のfieldを含めない
+	if raw := bs.Situation.ProtoReflect().GetUnknown(); len(raw) != 0 {
+		t.Fatalf("wanted no unknown fields but got %v", raw)
+	}
+	if bs.Clock != nil || bs.Ratings != nil {
+		t.Fatalf("wanted no clock and ratings but got %v", bs)
+	}
+}
+
+func TestSituation_Status(t *testing.T) {
+	const (
+		playerA  = "player_a"
+		playerB  = "player_b"
+		audience = "audience"
+	)
+	var (
+		opponentTurn = tictactoe_battle.BattleState_BATTLE_STATE_OPPONENT_TURN
+		unknown      = tictactoe_battle.BattleState_BATTLE_STATE_UNKNOWN
+	)
+
+	tests := []struct {
+		name       string
+		state      management_state.State
+		loginID    string
+		wantState  tictactoe_battle.BattleState
+		wantStatus tictactoe_battle_ext.GameStatus
+	}{
+		{name: "in progress", state: management_state.PlayerATurn, loginID: playerA,
+			wantState: tictactoe_battle.BattleState_BATTLE_STATE_PLAYER_TURN, wantStatus: tictactoe_battle_ext.GameStatus_GAME_STATUS_UNDEFINED},
+		{name: "draw", state: management_state.Draw, loginID: playerA,
+			wantState: unknown, wantStatus: tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW},
+		{name: "draw agreed", state: management_state.DrawAgreed, loginID: playerB,
+			wantState: unknown, wantStatus: tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW},
+		{name: "offered to player b", state: management_state.PlayerADrawOffered, loginID: playerB,
+			wantState: opponentTurn, wantStatus: tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW_OFFERED},
+		{name: "offered by player a", state: management_state.PlayerADrawOffered, loginID: playerA,
+			wantState: opponentTurn, wantStatus: tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW_OFFER_PENDING},
+		{name: "offered to player a", state: management_state.PlayerBDrawOffered, loginID: playerA,
+			wantState: opponentTurn, wantStatus: tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW_OFFERED},
+		{name: "audience", state: management_state.PlayerBDrawOffered, loginID: audience,
+			wantState: opponentTurn, wantStatus: tictactoe_battle_ext.GameStatus_GAME_STATUS_DRAW_OFFER_PENDING},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := battle.NewRule().OpenBattle(battle.DefaultRuleSet())
+			b.PlayerAID, b.PlayerBID = playerA, playerB
+			b.State = tt.state
+
+			bs, err := Situation(b, tt.loginID)
+			if err != nil {
+				t.Fatalf("failed to Situation: %v", err)
+			}
+			// tictactoe-battle-protoに定義された値のみ送信する
+			if _, ok := tictactoe_battle.BattleState_name[int32(bs.Situation.State)]; !ok {
+				t.Fatalf("undefined state: %d", bs.Situation.State)
+			}
+			if bs.Situation.State != tt.wantState || bs.Status != tt.wantStatus {
+				t.Fatalf("wanted (%s, %s) but got (%s, %s)", tt.wantState, tt.wantStatus, bs.Situation.State, bs.Status)
+			}
+		})
+	}
+}
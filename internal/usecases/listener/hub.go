@@ -0,0 +1,203 @@
+package listener
+
+import (
+	"context"
+	"sync"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/commons/loggers"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+)
+
+type (
+	// Hub is
+	//   プロセス内でroomごとに1つだけ購読を行い、同じroomのlistenerへ更新を配信する.
+	Hub interface {
+		// Join roomの購読を開始する. ctxが終了すると購読を解除する.
+		Join(ctx context.Context, roomID room.ID, loginID string) (ports.BattleListener, error)
+	}
+
+	hub struct {
+		battleRepo ports.BattleRepository
+
+		mu    sync.Mutex
+		rooms map[room.ID]*roomHub
+	}
+
+	roomHub struct {
+		roomID room.ID
+		cancel context.CancelFunc
+
+		// subscribers, latestはhub.muで保護する
+		subscribers map[*subscriber]struct{}
+		latest      *battle.Battle
+	}
+
+	subscriber struct {
+		loginID string
+		// battles 最新のbattleのみを保持する. 受信が遅れた場合は古いものを破棄する.
+		battles chan *battle.Battle
+		// left loginIDがroomから退出した、またはroomが削除された場合にcloseされる.
+		left     chan struct{}
+		leftOnce sync.Once
+	}
+)
+
+func NewHub(battleRepo ports.BattleRepository) Hub {
+	return &hub{
+		battleRepo: battleRepo,
+		rooms:      make(map[room.ID]*roomHub),
+	}
+}
+
+func (h *hub) Join(ctx context.Context, roomID room.ID, loginID string) (ports.BattleListener, error) {
+	sub := &subscriber{
+		loginID: loginID,
+		battles: make(chan *battle.Battle, 1),
+		left:    make(chan struct{}),
+	}
+
+	if err := h.subscribe(ctx, roomID, sub); err != nil {
+		return nil, xerrors.Errorf("failed to subscribe: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		h.unsubscribe(roomID, sub)
+	}()
+
+	return newBattleListener(loginID, sub), nil
+}
+
+func (h *hub) subscribe(ctx context.Context, roomID room.ID, sub *subscriber) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	rh, ok := h.rooms[roomID]
+	if !ok {
+		// roomの購読はリクエストのctxとは独立して、listenerが居なくなるまで継続する
+		roomCtx, cancel := context.WithCancel(loggers.LoggerToContext(context.Background(), loggers.Logger(ctx)))
+		events, err := h.battleRepo.Subscribe(roomCtx, roomID)
+		if err != nil {
+			cancel()
+			return xerrors.Errorf("failed to Subscribe: %w", err)
+		}
+
+		rh = &roomHub{
+			roomID:      roomID,
+			cancel:      cancel,
+			subscribers: make(map[*subscriber]struct{}),
+		}
+		h.rooms[roomID] = rh
+		go h.run(roomCtx, rh, events)
+	}
+
+	rh.subscribers[sub] = struct{}{}
+	if rh.latest != nil {
+		sub.send(rh.latest)
+	}
+
+	return nil
+}
+
+func (h *hub) unsubscribe(roomID room.ID, sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// 購読が終了したroomHubは既に削除され、同じroomの新しいroomHubに置き換わっている場合がある
+	rh, ok := h.rooms[roomID]
+	if !ok {
+		return
+	}
+	if _, ok := rh.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(rh.subscribers, sub)
+	if len(rh.subscribers) == 0 {
+		rh.cancel()
+		delete(h.rooms, roomID)
+	}
+}
+
+// run roomのEventを受信し、各subscriberへ配信する.
+func (h *hub) run(ctx context.Context, rh *roomHub, events <-chan room.Event) {
+	// 購読開始前の更新を取りこぼさないよう、最新の状態を配信してから待ち受ける
+	h.refresh(ctx, rh)
+
+	for ev := range events {
+		switch ev.Type {
+		case room.EventBattleUpdated:
+			h.refresh(ctx, rh)
+		case room.EventMemberLeft:
+			h.leave(rh, func(sub *subscriber) bool { return sub.loginID == ev.LoginID })
+		case room.EventClosed:
+			h.leave(rh, func(*subscriber) bool { return true })
+		}
+	}
+
+	// 購読が終了した場合は配信できないため、次のJoinで購読し直せるようroomHubを削除する
+	h.close(rh)
+}
+
+// close roomHubを削除し、全てのsubscriberを退出させる.
+func (h *hub) close(rh *roomHub) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	rh.cancel()
+	if h.rooms[rh.roomID] == rh {
+		delete(h.rooms, rh.roomID)
+	}
+	for sub := range rh.subscribers {
+		sub.leave()
+	}
+	rh.subscribers = make(map[*subscriber]struct{})
+}
+
+func (h *hub) refresh(ctx context.Context, rh *roomHub) {
+	_, b, err := h.battleRepo.ReadStreamLatest(ctx, rh.roomID)
+	if err != nil {
+		if ctx.Err() == nil {
+			loggers.Logger(ctx).Warn("failed to ReadStreamLatest", zap.String("room_id", rh.roomID.String()), zap.Error(err))
+		}
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	rh.latest = b
+	for sub := range rh.subscribers {
+		sub.send(b)
+	}
+}
+
+func (h *hub) leave(rh *roomHub, match func(sub *subscriber) bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range rh.subscribers {
+		if match(sub) {
+			sub.leave()
+		}
+	}
+}
+
+// send 未受信のbattleがあれば破棄して最新のものに置き換える. 送信はhub.muの保持中にのみ行う.
+func (s *subscriber) send(b *battle.Battle) {
+	select {
+	case <-s.battles:
+	default:
+	}
+	s.battles <- b
+}
+
+func (s *subscriber) leave() {
+	s.leftOnce.Do(func() {
+		close(s.left)
+	})
+}
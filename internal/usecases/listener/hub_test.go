@@ -0,0 +1,123 @@
+package listener
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
+	mock_ports "github.com/swallowarc/tictactoe_battle_backend/internal/tests/mocks/ports"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"golang.org/x/xerrors"
+)
+
+func TestHub(t *testing.T) {
+	const roomID = room.ID("12345")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rule := battle.NewRule()
+	opened := rule.OpenBattle(battle.DefaultRuleSet())
+	opened.RoomID = roomID
+	declared := rule.OpenBattle(battle.DefaultRuleSet())
+	declared.RoomID = roomID
+	declared.PlayerAID = "player_a"
+
+	events := make(chan room.Event)
+	battleRepo := mock_ports.NewMockBattleRepository(ctrl)
+	// 1つのroomに対して購読は1回のみ
+	battleRepo.EXPECT().Subscribe(gomock.Any(), roomID).Return(events, nil).Times(1)
+	gomock.InOrder(
+		battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-1", opened, nil),
+		battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-2", declared, nil),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	h := NewHub(battleRepo)
+	lsnrA, err := h.Join(ctx, roomID, "player_a")
+	if err != nil {
+		t.Fatalf("failed to Join: %v", err)
+	}
+	lsnrB, err := h.Join(ctx, roomID, "audience")
+	if err != nil {
+		t.Fatalf("failed to Join: %v", err)
+	}
+
+	if bs, err := lsnrA.Listen(ctx); err != nil {
+		t.Fatalf("failed to Listen: %v", err)
+	} else if bs.Situation.State != tictactoe_battle.BattleState_BATTLE_STATE_MEETING {
+		t.Fatalf("unexpected state: %s", bs.Situation.State)
+	}
+
+	events <- room.Event{Type: room.EventBattleUpdated}
+
+	for _, lsnr := range []interface {
+		Listen(ctx context.Context) (*tictactoe_battle_ext.BattleSituation, error)
+	}{lsnrA, lsnrB} {
+		bs, err := lsnr.Listen(ctx)
+		if err != nil {
+			t.Fatalf("failed to Listen: %v", err)
+		}
+		if bs.Situation.PlayerAId != "player_a" {
+			t.Fatalf("wanted latest battle but got %v", bs)
+		}
+	}
+
+	events <- room.Event{Type: room.EventMemberLeft, LoginID: "audience"}
+
+	if _, err := lsnrB.Listen(ctx); !xerrors.Is(err, LeftError) {
+		t.Fatalf("wanted LeftError but got %v", err)
+	}
+}
+
+func TestHub_EventsClosed(t *testing.T) {
+	const roomID = room.ID("12345")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rule := battle.NewRule()
+	opened := rule.OpenBattle(battle.DefaultRuleSet())
+	opened.RoomID = roomID
+
+	first, second := make(chan room.Event), make(chan room.Event)
+	battleRepo := mock_ports.NewMockBattleRepository(ctrl)
+	// 購読が終了した後のJoinでは購読し直す
+	gomock.InOrder(
+		battleRepo.EXPECT().Subscribe(gomock.Any(), roomID).Return(first, nil),
+		battleRepo.EXPECT().Subscribe(gomock.Any(), roomID).Return(second, nil),
+	)
+	battleRepo.EXPECT().ReadStreamLatest(gomock.Any(), roomID).Return("0-1", opened, nil).Times(2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	h := NewHub(battleRepo)
+	lsnr, err := h.Join(ctx, roomID, "player_a")
+	if err != nil {
+		t.Fatalf("failed to Join: %v", err)
+	}
+	if _, err := lsnr.Listen(ctx); err != nil {
+		t.Fatalf("failed to Listen: %v", err)
+	}
+
+	close(first)
+
+	if _, err := lsnr.Listen(ctx); !xerrors.Is(err, LeftError) {
+		t.Fatalf("wanted LeftError but got %v", err)
+	}
+
+	rejoined, err := h.Join(ctx, roomID, "player_a")
+	if err != nil {
+		t.Fatalf("failed to Join: %v", err)
+	}
+	if _, err := rejoined.Listen(ctx); err != nil {
+		t.Fatalf("failed to Listen: %v", err)
+	}
+}
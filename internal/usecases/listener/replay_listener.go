@@ -0,0 +1,73 @@
+package listener
+
+import (
+	"context"
+	"time"
+
+	"github.com/swallowarc/tictactoe_battle_backend/internal/usecases/ports"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// maxReplayInterval 再生時のframe間の最大待機時間. 長考した手番で待たされ続けないようにする.
+	maxReplayInterval = 5 * time.Second
+)
+
+type (
+	// ReplayFrame is
+	//   Replayの1コマ. PlayedAtの間隔を再生速度で割った時間だけ待機してから配信する.
+	ReplayFrame struct {
+		Situation *tictactoe_battle_ext.BattleSituation
+		PlayedAt  time.Time
+	}
+
+	replayListener struct {
+		frames []ReplayFrame
+		speed  float64
+		next   int
+	}
+)
+
+var ReplayEndedError = xerrors.New("replay has ended")
+
+func NewReplayListener(frames []ReplayFrame, speed float64) ports.BattleListener {
+	return &replayListener{
+		frames: frames,
+		speed:  speed,
+	}
+}
+
+// Listen 次のframeの再生時刻まで待機する. 全てのframeを配信した後はReplayEndedErrorを返す.
+func (l *replayListener) Listen(ctx context.Context) (*tictactoe_battle_ext.BattleSituation, error) {
+	if l.next >= len(l.frames) {
+		return nil, ReplayEndedError
+	}
+
+	if l.next > 0 {
+		timer := time.NewTimer(l.interval(l.frames[l.next-1], l.frames[l.next]))
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	f := l.frames[l.next]
+	l.next++
+	return f.Situation, nil
+}
+
+func (l *replayListener) interval(prev, next ReplayFrame) time.Duration {
+	d := time.Duration(float64(next.PlayedAt.Sub(prev.PlayedAt)) / l.speed)
+	switch {
+	case d < 0:
+		return 0
+	case d > maxReplayInterval:
+		return maxReplayInterval
+	default:
+		return d
+	}
+}
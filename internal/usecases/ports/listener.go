@@ -3,11 +3,11 @@ package ports
 import (
 	"context"
 
-	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/pkg/tictactoe_battle_ext"
 )
 
 type (
 	BattleListener interface {
-		Listen(ctx context.Context) (*tictactoe_battle.BattleSituation, error)
+		Listen(ctx context.Context) (*tictactoe_battle_ext.BattleSituation, error)
 	}
 )
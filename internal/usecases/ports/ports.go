@@ -4,5 +4,11 @@ type (
 	RepositoriesFactory interface {
 		LoginRepository() LoginRepository
 		BattleRepository() BattleRepository
+		ReplayRepository() ReplayRepository
+		RateLimitRepository() RateLimitRepository
+		GameArchiveRepository() GameArchiveRepository
+		RatingRepository() RatingRepository
+		LeaderboardRepository() LeaderboardRepository
+		SeasonRepository() SeasonRepository
 	}
 )
@@ -3,9 +3,13 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/archive"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/battle"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/leaderboard"
+	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/rating"
 	"github.com/swallowarc/tictactoe_battle_backend/internal/domains/room"
 )
 
@@ -19,13 +23,91 @@ type (
 
 	BattleRepository interface {
 		Create(ctx context.Context, battle *battle.Battle) (room.ID, error)
-		Update(ctx context.Context, battle *battle.Battle) error
+		// Update previousIDが最新のmessage idと一致する場合のみ更新する. 一致しない場合はConflictError.
+		//   moveは更新後のbattleと同じmessageに記録される.
+		Update(ctx context.Context, previousID string, battle *battle.Battle, move *battle.Move) error
 		Enter(ctx context.Context, roomID room.ID, loginID string) error
 		Leave(ctx context.Context, roomID room.ID, loginID string) error
 		ReadStreamLatest(ctx context.Context, roomID room.ID) (string, *battle.Battle, error)
-		ReadStream(ctx context.Context, roomID room.ID, messageID string) (string, *battle.Battle, error)
+		// ListMoves roomで行われたmoveを古い順に返す.
+		ListMoves(ctx context.Context, roomID room.ID) ([]*battle.Move, error)
 		ListMembers(ctx context.Context, roomID room.ID) ([]string, error)
 		IsExistsInRoom(ctx context.Context, roomID room.ID, loginID string) (bool, error)
 		Delete(ctx context.Context, roomID room.ID) error
+		// Subscribe roomで発生したEventを購読する. 返却されるchannelはctxの終了時にcloseされる.
+		Subscribe(ctx context.Context, roomID room.ID) (<-chan room.Event, error)
+	}
+
+	RateLimitRepository interface {
+		// Allow keyに対する前回の許可からintervalが経過している場合はtrueを返し、今回の許可を記録する.
+		Allow(ctx context.Context, key string, interval time.Duration) (bool, error)
+	}
+
+	ReplayRepository interface {
+		// Save 終了したゲームのmoveを保存する. roomの削除後も一定期間保持される.
+		Save(ctx context.Context, gameID battle.GameID, moves []*battle.Move) error
+		// FindMoves 保存済みのゲームのmoveを古い順に返す. 存在しない場合はNotFoundError.
+		FindMoves(ctx context.Context, gameID battle.GameID) ([]*battle.Move, error)
+	}
+
+	// GameArchiveRepository is
+	//   終了したゲームの永続的な記録. ReplayRepositoryと異なり期限なく保持する.
+	GameArchiveRepository interface {
+		// Save 終了したゲームを記録する. 同じゲームを再度記録した場合は上書きする.
+		Save(ctx context.Context, game *archive.Game) error
+		// Find 記録済みのゲームを返す. 存在しない場合はNotFoundError.
+		Find(ctx context.Context, gameID battle.GameID) (*archive.Game, error)
+		// ListByPlayer playerIDが対局したゲームを終了の新しい順にoffset件目からlimit件返す. limitが0の場合は全て返す.
+		//   一覧のためMovesは含まない.
+		ListByPlayer(ctx context.Context, playerID string, offset, limit int) ([]*archive.Game, error)
+		// ListUnrated ratingに反映されていないranked roomのゲームを終了の古い順にlimit件返す.
+		ListUnrated(ctx context.Context, limit int) ([]*archive.Game, error)
+		// CountOutcomes playerIDが対局したゲームの結果ごとの数を返す.
+		CountOutcomes(ctx context.Context, playerID string) (map[archive.Outcome]int, error)
+		// ListOutcomes playerIDが対局したゲームの結果を終了の新しい順にoffset件目からlimit件返す.
+		ListOutcomes(ctx context.Context, playerID string, offset, limit int) ([]archive.Outcome, error)
+		// FavoriteOpening playerIDが3x3の盤面で最初に駒を置いたマスで最も多いものを返す.
+		//   同数の場合は番号の小さいマスとし、無い場合はPOSITION_UNDEFINED.
+		FavoriteOpening(ctx context.Context, playerID string) (tictactoe_battle.Position, error)
+	}
+
+	// RatingRepository is
+	//   ranked roomの対局によるプレイヤーのrating. ログインのセッションとは別に期限なく保持する.
+	RatingRepository interface {
+		// Find playerIDのratingを返す. 記録が無い場合は初期値.
+		Find(ctx context.Context, playerID string) (*rating.Rating, error)
+		// FindResult gameIDのゲームによるratingの変化を返す. ratingに反映されていない場合はNotFoundError.
+		FindResult(ctx context.Context, gameID battle.GameID) (*rating.Result, error)
+		// Save gameIDのゲームによるratingの変化resultとratingsを1つのtransactionで保存する.
+		//   gameIDが既に反映済みの場合、またはいずれかの保存済みのGamesがratingのGamesより1つ少なくない場合は
+		//   何も保存せずにConflictErrorを返す.
+		Save(ctx context.Context, gameID battle.GameID, result *rating.Result, ratings ...*rating.Rating) error
+	}
+
+	// LeaderboardRepository is
+	//   ratingの順位表. 通算の順位表はseasonごと、日別と週別の順位表は期間ごとで、一定期間後に消える.
+	LeaderboardRepository interface {
+		// Record playerIDのratingをatを含む期間の全ての順位表に記録する.
+		Record(ctx context.Context, season int, playerID string, rating float64, at time.Time) error
+		// List atを含む期間のboardの順位表をratingの高い順にoffset件目からlimit件返す. limitが0の場合は全て返す.
+		//   ratingが同じ場合も順位は重複しない.
+		List(ctx context.Context, season int, board leaderboard.Board, at time.Time, offset, limit int) ([]leaderboard.Entry, error)
+		// Rank atを含む期間のboardの順位表でのplayerIDの順位を返す. 載っていない場合はNotFoundError.
+		Rank(ctx context.Context, season int, board leaderboard.Board, at time.Time, playerID string) (*leaderboard.Entry, error)
+		// Clear seasonの通算の順位表を削除する. 日別と週別の順位表はseasonに関係なく保持する.
+		Clear(ctx context.Context, season int) error
+	}
+
+	// SeasonRepository is
+	//   終了したseasonの最終的な順位. GameArchiveRepositoryと同様に期限なく保持する.
+	SeasonRepository interface {
+		// Current 進行中のseasonの番号を返す. 最後に終了したseasonの次の番号.
+		Current(ctx context.Context) (int, error)
+		// Close numberのseasonを終了したものとして記録する. 以降のCurrentは次の番号を返す. 既に終了している場合はConflictError.
+		Close(ctx context.Context, number int, closedAt time.Time) error
+		// SaveStandings 終了したnumberのseasonの最終的な順位を記録する. 既に記録されている場合は置き換える.
+		SaveStandings(ctx context.Context, number int, standings []leaderboard.Entry) error
+		// Find 終了したseasonを返す. 存在しない場合はNotFoundError.
+		Find(ctx context.Context, number int) (*leaderboard.Season, error)
 	}
 )
@@ -0,0 +1,3570 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: tictactoe_battle_ext/api.proto
+
+package tictactoe_battle_ext
+
+import (
+	tictactoe_battle "github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GameStatus tictactoe_battle.BattleStateに定義されていない対局の状態.
+type GameStatus int32
+
+const (
+	// GAME_STATUS_UNDEFINED situationのstateのとおり.
+	GameStatus_GAME_STATUS_UNDEFINED GameStatus = 0
+	// GAME_STATUS_DRAW 引き分けで終了した. situationのstateはBATTLE_STATE_UNKNOWN.
+	GameStatus_GAME_STATUS_DRAW GameStatus = 1
+	// GAME_STATUS_DRAW_OFFERED 相手から引き分けを提案され、回答を待たれている. situationのstateはOPPONENT_TURN.
+	GameStatus_GAME_STATUS_DRAW_OFFERED GameStatus = 2
+	// GAME_STATUS_DRAW_OFFER_PENDING 自分または観戦しているプレイヤーの引き分けの提案が回答を待っている. situationのstateはOPPONENT_TURNまたは観戦者のもの.
+	GameStatus_GAME_STATUS_DRAW_OFFER_PENDING GameStatus = 3
+)
+
+// Enum value maps for GameStatus.
+var (
+	GameStatus_name = map[int32]string{
+		0: "GAME_STATUS_UNDEFINED",
+		1: "GAME_STATUS_DRAW",
+		2: "GAME_STATUS_DRAW_OFFERED",
+		3: "GAME_STATUS_DRAW_OFFER_PENDING",
+	}
+	GameStatus_value = map[string]int32{
+		"GAME_STATUS_UNDEFINED":          0,
+		"GAME_STATUS_DRAW":               1,
+		"GAME_STATUS_DRAW_OFFERED":       2,
+		"GAME_STATUS_DRAW_OFFER_PENDING": 3,
+	}
+)
+
+func (x GameStatus) Enum() *GameStatus {
+	p := new(GameStatus)
+	*p = x
+	return p
+}
+
+func (x GameStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_tictactoe_battle_ext_api_proto_enumTypes[0].Descriptor()
+}
+
+func (GameStatus) Type() protoreflect.EnumType {
+	return &file_tictactoe_battle_ext_api_proto_enumTypes[0]
+}
+
+func (x GameStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameStatus.Descriptor instead.
+func (GameStatus) EnumDescriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{0}
+}
+
+// Outcome 最善を尽くした場合の手番のプレイヤーの結末.
+type Outcome int32
+
+const (
+	Outcome_OUTCOME_UNDEFINED Outcome = 0
+	// OUTCOME_UNDECIDED 制限時間内に勝敗を読み切れなかった.
+	Outcome_OUTCOME_UNDECIDED Outcome = 1
+	Outcome_OUTCOME_WIN       Outcome = 2
+	Outcome_OUTCOME_DRAW      Outcome = 3
+	Outcome_OUTCOME_LOSS      Outcome = 4
+)
+
+// Enum value maps for Outcome.
+var (
+	Outcome_name = map[int32]string{
+		0: "OUTCOME_UNDEFINED",
+		1: "OUTCOME_UNDECIDED",
+		2: "OUTCOME_WIN",
+		3: "OUTCOME_DRAW",
+		4: "OUTCOME_LOSS",
+	}
+	Outcome_value = map[string]int32{
+		"OUTCOME_UNDEFINED": 0,
+		"OUTCOME_UNDECIDED": 1,
+		"OUTCOME_WIN":       2,
+		"OUTCOME_DRAW":      3,
+		"OUTCOME_LOSS":      4,
+	}
+)
+
+func (x Outcome) Enum() *Outcome {
+	p := new(Outcome)
+	*p = x
+	return p
+}
+
+func (x Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_tictactoe_battle_ext_api_proto_enumTypes[1].Descriptor()
+}
+
+func (Outcome) Type() protoreflect.EnumType {
+	return &file_tictactoe_battle_ext_api_proto_enumTypes[1]
+}
+
+func (x Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Outcome.Descriptor instead.
+func (Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{1}
+}
+
+// BattleSituation tictactoe_battle.BattleSituationに、tictactoe-battle-protoに定義されていない対局の情報を加えたもの.
+type BattleSituation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Situation *tictactoe_battle.BattleSituation `protobuf:"bytes,1,opt,name=situation,proto3" json:"situation,omitempty"`
+	// clock 持ち時間. 持ち時間の無いroomでは設定されない.
+	Clock *Clock `protobuf:"bytes,2,opt,name=clock,proto3" json:"clock,omitempty"`
+	// series 再戦の通算成績. 成績は席ごとのもの.
+	Series *Series `protobuf:"bytes,3,opt,name=series,proto3" json:"series,omitempty"`
+	// rematch_requested_by 再戦を要求したプレイヤー. 要求が無い場合はPLAYER_UNKNOWN.
+	RematchRequestedBy tictactoe_battle.Player `protobuf:"varint,4,opt,name=rematch_requested_by,json=rematchRequestedBy,proto3,enum=tictactoe_battle.Player" json:"rematch_requested_by,omitempty"`
+	// ratings ranked roomのゲーム終了によるratingの変化. ratingに反映されるまでは設定されない.
+	Ratings *Ratings `protobuf:"bytes,5,opt,name=ratings,proto3" json:"ratings,omitempty"`
+	// status tictactoe_battle.BattleStateで表せない対局の状態.
+	Status GameStatus `protobuf:"varint,6,opt,name=status,proto3,enum=tictactoe_battle_ext.GameStatus" json:"status,omitempty"`
+	// reset_requested_by 対局中にResetBattleを要求したプレイヤー. 要求が無い場合や手が指されて取り消された場合はPLAYER_UNKNOWN.
+	ResetRequestedBy tictactoe_battle.Player `protobuf:"varint,7,opt,name=reset_requested_by,json=resetRequestedBy,proto3,enum=tictactoe_battle.Player" json:"reset_requested_by,omitempty"`
+}
+
+func (x *BattleSituation) Reset() {
+	*x = BattleSituation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BattleSituation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattleSituation) ProtoMessage() {}
+
+func (x *BattleSituation) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattleSituation.ProtoReflect.Descriptor instead.
+func (*BattleSituation) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BattleSituation) GetSituation() *tictactoe_battle.BattleSituation {
+	if x != nil {
+		return x.Situation
+	}
+	return nil
+}
+
+func (x *BattleSituation) GetClock() *Clock {
+	if x != nil {
+		return x.Clock
+	}
+	return nil
+}
+
+func (x *BattleSituation) GetSeries() *Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *BattleSituation) GetRematchRequestedBy() tictactoe_battle.Player {
+	if x != nil {
+		return x.RematchRequestedBy
+	}
+	return tictactoe_battle.Player(0)
+}
+
+func (x *BattleSituation) GetRatings() *Ratings {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *BattleSituation) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNDEFINED
+}
+
+func (x *BattleSituation) GetResetRequestedBy() tictactoe_battle.Player {
+	if x != nil {
+		return x.ResetRequestedBy
+	}
+	return tictactoe_battle.Player(0)
+}
+
+// Clock 両プレイヤーの持ち時間.
+// 残り時間は手番が始まった時点のもので、手番のプレイヤーの残り時間はturn_started_at_msからの経過時間を差し引いて求める.
+type Clock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerARemainingMs int64 `protobuf:"varint,1,opt,name=player_a_remaining_ms,json=playerARemainingMs,proto3" json:"player_a_remaining_ms,omitempty"`
+	PlayerBRemainingMs int64 `protobuf:"varint,2,opt,name=player_b_remaining_ms,json=playerBRemainingMs,proto3" json:"player_b_remaining_ms,omitempty"`
+	// turn_started_at_ms 手番の開始時刻のunix時間(ms). 時計が止まっている場合は0.
+	TurnStartedAtMs int64 `protobuf:"varint,3,opt,name=turn_started_at_ms,json=turnStartedAtMs,proto3" json:"turn_started_at_ms,omitempty"`
+}
+
+func (x *Clock) Reset() {
+	*x = Clock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clock) ProtoMessage() {}
+
+func (x *Clock) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clock.ProtoReflect.Descriptor instead.
+func (*Clock) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Clock) GetPlayerARemainingMs() int64 {
+	if x != nil {
+		return x.PlayerARemainingMs
+	}
+	return 0
+}
+
+func (x *Clock) GetPlayerBRemainingMs() int64 {
+	if x != nil {
+		return x.PlayerBRemainingMs
+	}
+	return 0
+}
+
+func (x *Clock) GetTurnStartedAtMs() int64 {
+	if x != nil {
+		return x.TurnStartedAtMs
+	}
+	return 0
+}
+
+type Series struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerAWins int32 `protobuf:"varint,1,opt,name=player_a_wins,json=playerAWins,proto3" json:"player_a_wins,omitempty"`
+	PlayerBWins int32 `protobuf:"varint,2,opt,name=player_b_wins,json=playerBWins,proto3" json:"player_b_wins,omitempty"`
+	Draws       int32 `protobuf:"varint,3,opt,name=draws,proto3" json:"draws,omitempty"`
+}
+
+func (x *Series) Reset() {
+	*x = Series{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Series) ProtoMessage() {}
+
+func (x *Series) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Series) GetPlayerAWins() int32 {
+	if x != nil {
+		return x.PlayerAWins
+	}
+	return 0
+}
+
+func (x *Series) GetPlayerBWins() int32 {
+	if x != nil {
+		return x.PlayerBWins
+	}
+	return 0
+}
+
+func (x *Series) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+// Ratings 変化後のratingと増減.
+type Ratings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerARating       float64 `protobuf:"fixed64,1,opt,name=player_a_rating,json=playerARating,proto3" json:"player_a_rating,omitempty"`
+	PlayerARatingChange float64 `protobuf:"fixed64,2,opt,name=player_a_rating_change,json=playerARatingChange,proto3" json:"player_a_rating_change,omitempty"`
+	PlayerBRating       float64 `protobuf:"fixed64,3,opt,name=player_b_rating,json=playerBRating,proto3" json:"player_b_rating,omitempty"`
+	PlayerBRatingChange float64 `protobuf:"fixed64,4,opt,name=player_b_rating_change,json=playerBRatingChange,proto3" json:"player_b_rating_change,omitempty"`
+}
+
+func (x *Ratings) Reset() {
+	*x = Ratings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ratings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ratings) ProtoMessage() {}
+
+func (x *Ratings) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ratings.ProtoReflect.Descriptor instead.
+func (*Ratings) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Ratings) GetPlayerARating() float64 {
+	if x != nil {
+		return x.PlayerARating
+	}
+	return 0
+}
+
+func (x *Ratings) GetPlayerARatingChange() float64 {
+	if x != nil {
+		return x.PlayerARatingChange
+	}
+	return 0
+}
+
+func (x *Ratings) GetPlayerBRating() float64 {
+	if x != nil {
+		return x.PlayerBRating
+	}
+	return 0
+}
+
+func (x *Ratings) GetPlayerBRatingChange() float64 {
+	if x != nil {
+		return x.PlayerBRatingChange
+	}
+	return 0
+}
+
+type EnterRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *EnterRoomRequest) Reset() {
+	*x = EnterRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnterRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnterRoomRequest) ProtoMessage() {}
+
+func (x *EnterRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnterRoomRequest.ProtoReflect.Descriptor instead.
+func (*EnterRoomRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EnterRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+// Action 1手番の着手. 持ち駒を置く場合のfromはPOSITION_UNDEFINED.
+type Action struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From  tictactoe_battle.Position `protobuf:"varint,1,opt,name=from,proto3,enum=tictactoe_battle.Position" json:"from,omitempty"`
+	To    tictactoe_battle.Position `protobuf:"varint,2,opt,name=to,proto3,enum=tictactoe_battle.Position" json:"to,omitempty"`
+	Piece tictactoe_battle.Piece    `protobuf:"varint,3,opt,name=piece,proto3,enum=tictactoe_battle.Piece" json:"piece,omitempty"`
+}
+
+func (x *Action) Reset() {
+	*x = Action{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action) ProtoMessage() {}
+
+func (x *Action) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action.ProtoReflect.Descriptor instead.
+func (*Action) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Action) GetFrom() tictactoe_battle.Position {
+	if x != nil {
+		return x.From
+	}
+	return tictactoe_battle.Position(0)
+}
+
+func (x *Action) GetTo() tictactoe_battle.Position {
+	if x != nil {
+		return x.To
+	}
+	return tictactoe_battle.Position(0)
+}
+
+func (x *Action) GetPiece() tictactoe_battle.Piece {
+	if x != nil {
+		return x.Piece
+	}
+	return tictactoe_battle.Piece(0)
+}
+
+type GetReplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetReplayRequest) Reset() {
+	*x = GetReplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplayRequest) ProtoMessage() {}
+
+func (x *GetReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplayRequest.ProtoReflect.Descriptor instead.
+func (*GetReplayRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetReplayRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetReplayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Situations []*BattleSituation `protobuf:"bytes,1,rep,name=situations,proto3" json:"situations,omitempty"`
+}
+
+func (x *GetReplayResponse) Reset() {
+	*x = GetReplayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReplayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplayResponse) ProtoMessage() {}
+
+func (x *GetReplayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplayResponse.ProtoReflect.Descriptor instead.
+func (*GetReplayResponse) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetReplayResponse) GetSituations() []*BattleSituation {
+	if x != nil {
+		return x.Situations
+	}
+	return nil
+}
+
+type StreamReplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// speed 再生速度の倍率. 0の場合は等速.
+	Speed float64 `protobuf:"fixed64,2,opt,name=speed,proto3" json:"speed,omitempty"`
+}
+
+func (x *StreamReplayRequest) Reset() {
+	*x = StreamReplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamReplayRequest) ProtoMessage() {}
+
+func (x *StreamReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamReplayRequest.ProtoReflect.Descriptor instead.
+func (*StreamReplayRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamReplayRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *StreamReplayRequest) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type LegalMovesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *LegalMovesRequest) Reset() {
+	*x = LegalMovesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegalMovesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalMovesRequest) ProtoMessage() {}
+
+func (x *LegalMovesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalMovesRequest.ProtoReflect.Descriptor instead.
+func (*LegalMovesRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LegalMovesRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type LegalMovesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions []*Action `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *LegalMovesResponse) Reset() {
+	*x = LegalMovesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegalMovesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalMovesResponse) ProtoMessage() {}
+
+func (x *LegalMovesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalMovesResponse.ProtoReflect.Descriptor instead.
+func (*LegalMovesResponse) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LegalMovesResponse) GetActions() []*Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type SuggestMoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *SuggestMoveRequest) Reset() {
+	*x = SuggestMoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestMoveRequest) ProtoMessage() {}
+
+func (x *SuggestMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestMoveRequest.ProtoReflect.Descriptor instead.
+func (*SuggestMoveRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SuggestMoveRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type SuggestMoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action  *Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Outcome Outcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=tictactoe_battle_ext.Outcome" json:"outcome,omitempty"`
+	// moves 勝敗が決まるまでの勝者の手数. outcomeがWINまたはLOSSの場合のみ設定される.
+	Moves int32 `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+}
+
+func (x *SuggestMoveResponse) Reset() {
+	*x = SuggestMoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestMoveResponse) ProtoMessage() {}
+
+func (x *SuggestMoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestMoveResponse.ProtoReflect.Descriptor instead.
+func (*SuggestMoveResponse) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SuggestMoveResponse) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *SuggestMoveResponse) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNDEFINED
+}
+
+func (x *SuggestMoveResponse) GetMoves() int32 {
+	if x != nil {
+		return x.Moves
+	}
+	return 0
+}
+
+// GobbletAction ModeGobbletの1手番の着手. fromが-1の場合はstackの山から打ち、それ以外は盤上の駒を動かす.
+// positionは左上から右へ0,1,2...と数える.
+type GobbletAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stack int32 `protobuf:"varint,1,opt,name=stack,proto3" json:"stack,omitempty"`
+	From  int32 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To    int32 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GobbletAction) Reset() {
+	*x = GobbletAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GobbletAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GobbletAction) ProtoMessage() {}
+
+func (x *GobbletAction) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GobbletAction.ProtoReflect.Descriptor instead.
+func (*GobbletAction) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GobbletAction) GetStack() int32 {
+	if x != nil {
+		return x.Stack
+	}
+	return 0
+}
+
+func (x *GobbletAction) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GobbletAction) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type PlayGobbletRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string         `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Action *GobbletAction `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *PlayGobbletRequest) Reset() {
+	*x = PlayGobbletRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayGobbletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayGobbletRequest) ProtoMessage() {}
+
+func (x *PlayGobbletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayGobbletRequest.ProtoReflect.Descriptor instead.
+func (*PlayGobbletRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PlayGobbletRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *PlayGobbletRequest) GetAction() *GobbletAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type GobbletBoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *GobbletBoardRequest) Reset() {
+	*x = GobbletBoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GobbletBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GobbletBoardRequest) ProtoMessage() {}
+
+func (x *GobbletBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GobbletBoardRequest.ProtoReflect.Descriptor instead.
+func (*GobbletBoardRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GobbletBoardRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+// GobbletPiece ModeGobbletの盤上の駒. sizeは0(最小)から3(最大).
+type GobbletPiece struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner tictactoe_battle.Player `protobuf:"varint,1,opt,name=owner,proto3,enum=tictactoe_battle.Player" json:"owner,omitempty"`
+	Size  int32                   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *GobbletPiece) Reset() {
+	*x = GobbletPiece{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GobbletPiece) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GobbletPiece) ProtoMessage() {}
+
+func (x *GobbletPiece) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GobbletPiece.ProtoReflect.Descriptor instead.
+func (*GobbletPiece) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GobbletPiece) GetOwner() tictactoe_battle.Player {
+	if x != nil {
+		return x.Owner
+	}
+	return tictactoe_battle.Player(0)
+}
+
+func (x *GobbletPiece) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// GobbletCell 1マスに下から順に積まれた駒.
+type GobbletCell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pieces []*GobbletPiece `protobuf:"bytes,1,rep,name=pieces,proto3" json:"pieces,omitempty"`
+}
+
+func (x *GobbletCell) Reset() {
+	*x = GobbletCell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GobbletCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GobbletCell) ProtoMessage() {}
+
+func (x *GobbletCell) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GobbletCell.ProtoReflect.Descriptor instead.
+func (*GobbletCell) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GobbletCell) GetPieces() []*GobbletPiece {
+	if x != nil {
+		return x.Pieces
+	}
+	return nil
+}
+
+// GobbletLine 揃った1ラインのposition.
+type GobbletLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Positions []int32 `protobuf:"varint,1,rep,packed,name=positions,proto3" json:"positions,omitempty"`
+}
+
+func (x *GobbletLine) Reset() {
+	*x = GobbletLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GobbletLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GobbletLine) ProtoMessage() {}
+
+func (x *GobbletLine) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GobbletLine.ProtoReflect.Descriptor instead.
+func (*GobbletLine) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GobbletLine) GetPositions() []int32 {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type GobbletBoardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells []*GobbletCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	// player_a_stacks player_b_stacks 外部の山ごとの残りの駒の数.
+	PlayerAStacks []int32 `protobuf:"varint,2,rep,packed,name=player_a_stacks,json=playerAStacks,proto3" json:"player_a_stacks,omitempty"`
+	PlayerBStacks []int32 `protobuf:"varint,3,rep,packed,name=player_b_stacks,json=playerBStacks,proto3" json:"player_b_stacks,omitempty"`
+	// win_lines 勝者の揃った全てのライン.
+	WinLines []*GobbletLine `protobuf:"bytes,4,rep,name=win_lines,json=winLines,proto3" json:"win_lines,omitempty"`
+}
+
+func (x *GobbletBoardResponse) Reset() {
+	*x = GobbletBoardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GobbletBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GobbletBoardResponse) ProtoMessage() {}
+
+func (x *GobbletBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GobbletBoardResponse.ProtoReflect.Descriptor instead.
+func (*GobbletBoardResponse) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GobbletBoardResponse) GetCells() []*GobbletCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *GobbletBoardResponse) GetPlayerAStacks() []int32 {
+	if x != nil {
+		return x.PlayerAStacks
+	}
+	return nil
+}
+
+func (x *GobbletBoardResponse) GetPlayerBStacks() []int32 {
+	if x != nil {
+		return x.PlayerBStacks
+	}
+	return nil
+}
+
+func (x *GobbletBoardResponse) GetWinLines() []*GobbletLine {
+	if x != nil {
+		return x.WinLines
+	}
+	return nil
+}
+
+type ResignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *ResignRequest) Reset() {
+	*x = ResignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResignRequest) ProtoMessage() {}
+
+func (x *ResignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResignRequest.ProtoReflect.Descriptor instead.
+func (*ResignRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResignRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type OfferDrawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *OfferDrawRequest) Reset() {
+	*x = OfferDrawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferDrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferDrawRequest) ProtoMessage() {}
+
+func (x *OfferDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferDrawRequest.ProtoReflect.Descriptor instead.
+func (*OfferDrawRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OfferDrawRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type AcceptDrawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *AcceptDrawRequest) Reset() {
+	*x = AcceptDrawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptDrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptDrawRequest) ProtoMessage() {}
+
+func (x *AcceptDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptDrawRequest.ProtoReflect.Descriptor instead.
+func (*AcceptDrawRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AcceptDrawRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type DeclineDrawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *DeclineDrawRequest) Reset() {
+	*x = DeclineDrawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineDrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineDrawRequest) ProtoMessage() {}
+
+func (x *DeclineDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineDrawRequest.ProtoReflect.Descriptor instead.
+func (*DeclineDrawRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeclineDrawRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type RequestRematchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *RequestRematchRequest) Reset() {
+	*x = RequestRematchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestRematchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRematchRequest) ProtoMessage() {}
+
+func (x *RequestRematchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRematchRequest.ProtoReflect.Descriptor instead.
+func (*RequestRematchRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RequestRematchRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type AcceptRematchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *AcceptRematchRequest) Reset() {
+	*x = AcceptRematchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptRematchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptRematchRequest) ProtoMessage() {}
+
+func (x *AcceptRematchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptRematchRequest.ProtoReflect.Descriptor instead.
+func (*AcceptRematchRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AcceptRematchRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type GetPlayerProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginId string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+}
+
+func (x *GetPlayerProfileRequest) Reset() {
+	*x = GetPlayerProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerProfileRequest) ProtoMessage() {}
+
+func (x *GetPlayerProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerProfileRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPlayerProfileRequest) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+type GetPlayerProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginId string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	Wins    int32  `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses  int32  `protobuf:"varint,3,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws   int32  `protobuf:"varint,4,opt,name=draws,proto3" json:"draws,omitempty"`
+	// streak 直近の連勝数. 連敗中は負の値となり、直近のゲームが引き分けの場合は0.
+	Streak int32 `protobuf:"varint,5,opt,name=streak,proto3" json:"streak,omitempty"`
+	// favorite_opening 最初に駒を置いたマスで最も多いもの. 3x3の盤面のゲームのみ集計する.
+	FavoriteOpening tictactoe_battle.Position `protobuf:"varint,6,opt,name=favorite_opening,json=favoriteOpening,proto3,enum=tictactoe_battle.Position" json:"favorite_opening,omitempty"`
+}
+
+func (x *GetPlayerProfileResponse) Reset() {
+	*x = GetPlayerProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerProfileResponse) ProtoMessage() {}
+
+func (x *GetPlayerProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerProfileResponse) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetPlayerProfileResponse) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *GetPlayerProfileResponse) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *GetPlayerProfileResponse) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *GetPlayerProfileResponse) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *GetPlayerProfileResponse) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *GetPlayerProfileResponse) GetFavoriteOpening() tictactoe_battle.Position {
+	if x != nil {
+		return x.FavoriteOpening
+	}
+	return tictactoe_battle.Position(0)
+}
+
+type ListMatchHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginId string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	// page_token 前回のnext_page_token. 最初のページは空文字.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// page_size 0の場合は20件. 最大100件.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListMatchHistoryRequest) Reset() {
+	*x = ListMatchHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMatchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchHistoryRequest) ProtoMessage() {}
+
+func (x *ListMatchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMatchHistoryRequest) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *ListMatchHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMatchHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Match プレイヤーの視点から見た1ゲームの結果.
+type Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId     string                  `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Player     tictactoe_battle.Player `protobuf:"varint,2,opt,name=player,proto3,enum=tictactoe_battle.Player" json:"player,omitempty"`
+	OpponentId string                  `protobuf:"bytes,3,opt,name=opponent_id,json=opponentId,proto3" json:"opponent_id,omitempty"`
+	// outcome win, loss, drawのいずれか.
+	Outcome string `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// reason line, timeout, resign, agreement, draw_ruleのいずれか.
+	Reason       string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Mode         string `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	Ranked       bool   `protobuf:"varint,7,opt,name=ranked,proto3" json:"ranked,omitempty"`
+	MoveCount    int32  `protobuf:"varint,8,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+	StartedAtMs  int64  `protobuf:"varint,9,opt,name=started_at_ms,json=startedAtMs,proto3" json:"started_at_ms,omitempty"`
+	FinishedAtMs int64  `protobuf:"varint,10,opt,name=finished_at_ms,json=finishedAtMs,proto3" json:"finished_at_ms,omitempty"`
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Match) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Match) GetPlayer() tictactoe_battle.Player {
+	if x != nil {
+		return x.Player
+	}
+	return tictactoe_battle.Player(0)
+}
+
+func (x *Match) GetOpponentId() string {
+	if x != nil {
+		return x.OpponentId
+	}
+	return ""
+}
+
+func (x *Match) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *Match) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Match) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Match) GetRanked() bool {
+	if x != nil {
+		return x.Ranked
+	}
+	return false
+}
+
+func (x *Match) GetMoveCount() int32 {
+	if x != nil {
+		return x.MoveCount
+	}
+	return 0
+}
+
+func (x *Match) GetStartedAtMs() int64 {
+	if x != nil {
+		return x.StartedAtMs
+	}
+	return 0
+}
+
+func (x *Match) GetFinishedAtMs() int64 {
+	if x != nil {
+		return x.FinishedAtMs
+	}
+	return 0
+}
+
+type ListMatchHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matches []*Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// next_page_token 空文字の場合は最後のページ.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListMatchHistoryResponse) Reset() {
+	*x = ListMatchHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMatchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchHistoryResponse) ProtoMessage() {}
+
+func (x *ListMatchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListMatchHistoryResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *ListMatchHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// LeaderboardEntry 順位表の1行.
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank    int32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	LoginId string  `protobuf:"bytes,2,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	Rating  float64 `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+// Season 終了したseasonの通算の順位表の最終結果.
+type Season struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number     int32               `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	ClosedAtMs int64               `protobuf:"varint,2,opt,name=closed_at_ms,json=closedAtMs,proto3" json:"closed_at_ms,omitempty"`
+	Standings  []*LeaderboardEntry `protobuf:"bytes,3,rep,name=standings,proto3" json:"standings,omitempty"`
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Season) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Season) GetClosedAtMs() int64 {
+	if x != nil {
+		return x.ClosedAtMs
+	}
+	return 0
+}
+
+func (x *Season) GetStandings() []*LeaderboardEntry {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type GetLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// board daily, weekly, all_timeのいずれか. 空文字の場合はall_time.
+	Board string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	// page_token 前回のnext_page_token. 最初のページは空文字.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// page_size 0の場合は20件. 最大100件.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetLeaderboardRequest) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board   string              `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Season  int32               `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Entries []*LeaderboardEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	// next_page_token 空文字の場合は最後のページ.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetLeaderboardResponse) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *GetLeaderboardResponse) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMyRankRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// board daily, weekly, all_timeのいずれか. 空文字の場合はall_time.
+	Board string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+}
+
+func (x *GetMyRankRequest) Reset() {
+	*x = GetMyRankRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyRankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyRankRequest) ProtoMessage() {}
+
+func (x *GetMyRankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyRankRequest.ProtoReflect.Descriptor instead.
+func (*GetMyRankRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetMyRankRequest) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+type GetMyRankResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *LeaderboardEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *GetMyRankResponse) Reset() {
+	*x = GetMyRankResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyRankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyRankResponse) ProtoMessage() {}
+
+func (x *GetMyRankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyRankResponse.ProtoReflect.Descriptor instead.
+func (*GetMyRankResponse) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetMyRankResponse) GetEntry() *LeaderboardEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetSeasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number int32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *GetSeasonRequest) Reset() {
+	*x = GetSeasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonRequest) ProtoMessage() {}
+
+func (x *GetSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetSeasonRequest) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type GetSeasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Season *Season `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+}
+
+func (x *GetSeasonResponse) Reset() {
+	*x = GetSeasonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonResponse) ProtoMessage() {}
+
+func (x *GetSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonResponse.ProtoReflect.Descriptor instead.
+func (*GetSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetSeasonResponse) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+type CloseSeasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseSeasonRequest) Reset() {
+	*x = CloseSeasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSeasonRequest) ProtoMessage() {}
+
+func (x *CloseSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseSeasonRequest.ProtoReflect.Descriptor instead.
+func (*CloseSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{39}
+}
+
+type CloseSeasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// season 終了したseason.
+	Season *Season `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+}
+
+func (x *CloseSeasonResponse) Reset() {
+	*x = CloseSeasonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tictactoe_battle_ext_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSeasonResponse) ProtoMessage() {}
+
+func (x *CloseSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tictactoe_battle_ext_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseSeasonResponse.ProtoReflect.Descriptor instead.
+func (*CloseSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_tictactoe_battle_ext_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CloseSeasonResponse) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+var File_tictactoe_battle_ext_api_proto protoreflect.FileDescriptor
+
+var file_tictactoe_battle_ext_api_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x14, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x1a, 0x1a, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f,
+	0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61,
+	0x74, 0x74, 0x6c, 0x65, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x03, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x69,
+	0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x69, 0x74, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x69, 0x63,
+	0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x42, 0x61,
+	0x74, 0x74, 0x6c, 0x65, 0x53, 0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73,
+	0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63,
+	0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x06, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x69,
+	0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x4a, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x37, 0x0a,
+	0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x07, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74,
+	0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x46, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74,
+	0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x10, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x9a, 0x01, 0x0a, 0x05, 0x43, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x31, 0x0a, 0x15, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x61, 0x5f, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x4d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x62, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x52, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x74, 0x75, 0x72, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x4d, 0x73, 0x22, 0x66, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x61, 0x5f, 0x77, 0x69, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x57,
+	0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x5f,
+	0x77, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x42, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x22, 0xc3, 0x01,
+	0x0a, 0x07, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x61, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x33, 0x0a, 0x16, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x61, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x13, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x62, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x33,
+	0x0a, 0x16, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x22, 0x93, 0x01, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x69, 0x63, 0x74,
+	0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63,
+	0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x69, 0x65, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74,
+	0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x52,
+	0x05, 0x70, 0x69, 0x65, 0x63, 0x65, 0x22, 0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x73, 0x69, 0x74, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74,
+	0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f,
+	0x65, 0x78, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x69, 0x74, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x44, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x11, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x4d, 0x6f,
+	0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x12, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x69, 0x63,
+	0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78,
+	0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x2d, 0x0a, 0x12, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x22, 0x9a, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61,
+	0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x22, 0x49, 0x0a,
+	0x0d, 0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x6a, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79,
+	0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63,
+	0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x13, 0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x0c, 0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x50,
+	0x69, 0x65, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f,
+	0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x49, 0x0a, 0x0b, 0x47, 0x6f, 0x62, 0x62,
+	0x6c, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x69, 0x65, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63,
+	0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x50, 0x69, 0x65, 0x63, 0x65, 0x52, 0x06, 0x70, 0x69, 0x65,
+	0x63, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x0b, 0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xdf, 0x01, 0x0a, 0x14, 0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61,
+	0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x61, 0x5f, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x41, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x53, 0x74, 0x61, 0x63,
+	0x6b, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f,
+	0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x6f, 0x62,
+	0x62, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x4c, 0x69, 0x6e,
+	0x65, 0x73, 0x22, 0x28, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x10,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x11, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x12, 0x44, 0x65, 0x63, 0x6c, 0x69,
+	0x6e, 0x65, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x22,
+	0xd6, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x6f, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x69,
+	0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x70, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xba, 0x02, 0x0a, 0x05, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x6b, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
+	0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4d,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x22, 0x79, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65,
+	0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x59, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x88, 0x01,
+	0x0a, 0x06, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74,
+	0x4d, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f,
+	0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x69, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x28, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x52,
+	0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x22, 0x51, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65,
+	0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0x2a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65,
+	0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x4b, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63,
+	0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2a, 0x7f, 0x0a,
+	0x0a, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x47,
+	0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x57, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x57,
+	0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x47, 0x41,
+	0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x57, 0x5f, 0x4f,
+	0x46, 0x46, 0x45, 0x52, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x2a, 0x6c,
+	0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x55, 0x54,
+	0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45,
+	0x43, 0x49, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x55, 0x54, 0x43, 0x4f,
+	0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x43,
+	0x4f, 0x4d, 0x45, 0x5f, 0x44, 0x52, 0x41, 0x57, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x55,
+	0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x04, 0x32, 0x99, 0x0e, 0x0a,
+	0x19, 0x54, 0x69, 0x63, 0x54, 0x61, 0x63, 0x54, 0x6f, 0x65, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x45, 0x78, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x45, 0x6e,
+	0x74, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x26, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63,
+	0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x45,
+	0x6e, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x69, 0x74,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x26, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f,
+	0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x29, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74,
+	0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61,
+	0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x53,
+	0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x0a, 0x4c, 0x65,
+	0x67, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61,
+	0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61,
+	0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x4d, 0x6f,
+	0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x28, 0x2e, 0x74, 0x69, 0x63,
+	0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78,
+	0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65,
+	0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x51, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x12, 0x28,
+	0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61,
+	0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x65, 0x0a, 0x0c, 0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x29, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62,
+	0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65,
+	0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x52, 0x65, 0x73,
+	0x69, 0x67, 0x6e, 0x12, 0x23, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f,
+	0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61,
+	0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x4d, 0x0a, 0x09, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x72, 0x61, 0x77, 0x12,
+	0x26, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63,
+	0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x4f, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x44, 0x72, 0x61, 0x77, 0x12,
+	0x27, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x44, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61,
+	0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x51, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x72, 0x61,
+	0x77, 0x12, 0x28, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61,
+	0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65,
+	0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x69,
+	0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e,
+	0x6f, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x57, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2b, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63,
+	0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65,
+	0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x55,
+	0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x2a, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x69,
+	0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e,
+	0x6f, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x71, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x2e, 0x74, 0x69, 0x63, 0x74,
+	0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61,
+	0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2d, 0x2e, 0x74,
+	0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f,
+	0x65, 0x78, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x69,
+	0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x2b, 0x2e,
+	0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x69, 0x63,
+	0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d,
+	0x79, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x26, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f,
+	0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f,
+	0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x69,
+	0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f,
+	0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x5f, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x5e, 0x5a, 0x5c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x72,
+	0x63, 0x2f, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74,
+	0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x5f,
+	0x65, 0x78, 0x74, 0x3b, 0x74, 0x69, 0x63, 0x74, 0x61, 0x63, 0x74, 0x6f, 0x65, 0x5f, 0x62, 0x61,
+	0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tictactoe_battle_ext_api_proto_rawDescOnce sync.Once
+	file_tictactoe_battle_ext_api_proto_rawDescData = file_tictactoe_battle_ext_api_proto_rawDesc
+)
+
+func file_tictactoe_battle_ext_api_proto_rawDescGZIP() []byte {
+	file_tictactoe_battle_ext_api_proto_rawDescOnce.Do(func() {
+		file_tictactoe_battle_ext_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_tictactoe_battle_ext_api_proto_rawDescData)
+	})
+	return file_tictactoe_battle_ext_api_proto_rawDescData
+}
+
+var file_tictactoe_battle_ext_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_tictactoe_battle_ext_api_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_tictactoe_battle_ext_api_proto_goTypes = []interface{}{
+	(GameStatus)(0),                          // 0: tictactoe_battle_ext.GameStatus
+	(Outcome)(0),                             // 1: tictactoe_battle_ext.Outcome
+	(*BattleSituation)(nil),                  // 2: tictactoe_battle_ext.BattleSituation
+	(*Clock)(nil),                            // 3: tictactoe_battle_ext.Clock
+	(*Series)(nil),                           // 4: tictactoe_battle_ext.Series
+	(*Ratings)(nil),                          // 5: tictactoe_battle_ext.Ratings
+	(*EnterRoomRequest)(nil),                 // 6: tictactoe_battle_ext.EnterRoomRequest
+	(*Action)(nil),                           // 7: tictactoe_battle_ext.Action
+	(*GetReplayRequest)(nil),                 // 8: tictactoe_battle_ext.GetReplayRequest
+	(*GetReplayResponse)(nil),                // 9: tictactoe_battle_ext.GetReplayResponse
+	(*StreamReplayRequest)(nil),              // 10: tictactoe_battle_ext.StreamReplayRequest
+	(*LegalMovesRequest)(nil),                // 11: tictactoe_battle_ext.LegalMovesRequest
+	(*LegalMovesResponse)(nil),               // 12: tictactoe_battle_ext.LegalMovesResponse
+	(*SuggestMoveRequest)(nil),               // 13: tictactoe_battle_ext.SuggestMoveRequest
+	(*SuggestMoveResponse)(nil),              // 14: tictactoe_battle_ext.SuggestMoveResponse
+	(*GobbletAction)(nil),                    // 15: tictactoe_battle_ext.GobbletAction
+	(*PlayGobbletRequest)(nil),               // 16: tictactoe_battle_ext.PlayGobbletRequest
+	(*GobbletBoardRequest)(nil),              // 17: tictactoe_battle_ext.GobbletBoardRequest
+	(*GobbletPiece)(nil),                     // 18: tictactoe_battle_ext.GobbletPiece
+	(*GobbletCell)(nil),                      // 19: tictactoe_battle_ext.GobbletCell
+	(*GobbletLine)(nil),                      // 20: tictactoe_battle_ext.GobbletLine
+	(*GobbletBoardResponse)(nil),             // 21: tictactoe_battle_ext.GobbletBoardResponse
+	(*ResignRequest)(nil),                    // 22: tictactoe_battle_ext.ResignRequest
+	(*OfferDrawRequest)(nil),                 // 23: tictactoe_battle_ext.OfferDrawRequest
+	(*AcceptDrawRequest)(nil),                // 24: tictactoe_battle_ext.AcceptDrawRequest
+	(*DeclineDrawRequest)(nil),               // 25: tictactoe_battle_ext.DeclineDrawRequest
+	(*RequestRematchRequest)(nil),            // 26: tictactoe_battle_ext.RequestRematchRequest
+	(*AcceptRematchRequest)(nil),             // 27: tictactoe_battle_ext.AcceptRematchRequest
+	(*GetPlayerProfileRequest)(nil),          // 28: tictactoe_battle_ext.GetPlayerProfileRequest
+	(*GetPlayerProfileResponse)(nil),         // 29: tictactoe_battle_ext.GetPlayerProfileResponse
+	(*ListMatchHistoryRequest)(nil),          // 30: tictactoe_battle_ext.ListMatchHistoryRequest
+	(*Match)(nil),                            // 31: tictactoe_battle_ext.Match
+	(*ListMatchHistoryResponse)(nil),         // 32: tictactoe_battle_ext.ListMatchHistoryResponse
+	(*LeaderboardEntry)(nil),                 // 33: tictactoe_battle_ext.LeaderboardEntry
+	(*Season)(nil),                           // 34: tictactoe_battle_ext.Season
+	(*GetLeaderboardRequest)(nil),            // 35: tictactoe_battle_ext.GetLeaderboardRequest
+	(*GetLeaderboardResponse)(nil),           // 36: tictactoe_battle_ext.GetLeaderboardResponse
+	(*GetMyRankRequest)(nil),                 // 37: tictactoe_battle_ext.GetMyRankRequest
+	(*GetMyRankResponse)(nil),                // 38: tictactoe_battle_ext.GetMyRankResponse
+	(*GetSeasonRequest)(nil),                 // 39: tictactoe_battle_ext.GetSeasonRequest
+	(*GetSeasonResponse)(nil),                // 40: tictactoe_battle_ext.GetSeasonResponse
+	(*CloseSeasonRequest)(nil),               // 41: tictactoe_battle_ext.CloseSeasonRequest
+	(*CloseSeasonResponse)(nil),              // 42: tictactoe_battle_ext.CloseSeasonResponse
+	(*tictactoe_battle.BattleSituation)(nil), // 43: tictactoe_battle.BattleSituation
+	(tictactoe_battle.Player)(0),             // 44: tictactoe_battle.Player
+	(tictactoe_battle.Position)(0),           // 45: tictactoe_battle.Position
+	(tictactoe_battle.Piece)(0),              // 46: tictactoe_battle.Piece
+	(*tictactoe_battle.NoBody)(nil),          // 47: tictactoe_battle.NoBody
+}
+var file_tictactoe_battle_ext_api_proto_depIdxs = []int32{
+	43, // 0: tictactoe_battle_ext.BattleSituation.situation:type_name -> tictactoe_battle.BattleSituation
+	3,  // 1: tictactoe_battle_ext.BattleSituation.clock:type_name -> tictactoe_battle_ext.Clock
+	4,  // 2: tictactoe_battle_ext.BattleSituation.series:type_name -> tictactoe_battle_ext.Series
+	44, // 3: tictactoe_battle_ext.BattleSituation.rematch_requested_by:type_name -> tictactoe_battle.Player
+	5,  // 4: tictactoe_battle_ext.BattleSituation.ratings:type_name -> tictactoe_battle_ext.Ratings
+	0,  // 5: tictactoe_battle_ext.BattleSituation.status:type_name -> tictactoe_battle_ext.GameStatus
+	44, // 6: tictactoe_battle_ext.BattleSituation.reset_requested_by:type_name -> tictactoe_battle.Player
+	45, // 7: tictactoe_battle_ext.Action.from:type_name -> tictactoe_battle.Position
+	45, // 8: tictactoe_battle_ext.Action.to:type_name -> tictactoe_battle.Position
+	46, // 9: tictactoe_battle_ext.Action.piece:type_name -> tictactoe_battle.Piece
+	2,  // 10: tictactoe_battle_ext.GetReplayResponse.situations:type_name -> tictactoe_battle_ext.BattleSituation
+	7,  // 11: tictactoe_battle_ext.LegalMovesResponse.actions:type_name -> tictactoe_battle_ext.Action
+	7,  // 12: tictactoe_battle_ext.SuggestMoveResponse.action:type_name -> tictactoe_battle_ext.Action
+	1,  // 13: tictactoe_battle_ext.SuggestMoveResponse.outcome:type_name -> tictactoe_battle_ext.Outcome
+	15, // 14: tictactoe_battle_ext.PlayGobbletRequest.action:type_name -> tictactoe_battle_ext.GobbletAction
+	44, // 15: tictactoe_battle_ext.GobbletPiece.owner:type_name -> tictactoe_battle.Player
+	18, // 16: tictactoe_battle_ext.GobbletCell.pieces:type_name -> tictactoe_battle_ext.GobbletPiece
+	19, // 17: tictactoe_battle_ext.GobbletBoardResponse.cells:type_name -> tictactoe_battle_ext.GobbletCell
+	20, // 18: tictactoe_battle_ext.GobbletBoardResponse.win_lines:type_name -> tictactoe_battle_ext.GobbletLine
+	45, // 19: tictactoe_battle_ext.GetPlayerProfileResponse.favorite_opening:type_name -> tictactoe_battle.Position
+	44, // 20: tictactoe_battle_ext.Match.player:type_name -> tictactoe_battle.Player
+	31, // 21: tictactoe_battle_ext.ListMatchHistoryResponse.matches:type_name -> tictactoe_battle_ext.Match
+	33, // 22: tictactoe_battle_ext.Season.standings:type_name -> tictactoe_battle_ext.LeaderboardEntry
+	33, // 23: tictactoe_battle_ext.GetLeaderboardResponse.entries:type_name -> tictactoe_battle_ext.LeaderboardEntry
+	33, // 24: tictactoe_battle_ext.GetMyRankResponse.entry:type_name -> tictactoe_battle_ext.LeaderboardEntry
+	34, // 25: tictactoe_battle_ext.GetSeasonResponse.season:type_name -> tictactoe_battle_ext.Season
+	34, // 26: tictactoe_battle_ext.CloseSeasonResponse.season:type_name -> tictactoe_battle_ext.Season
+	6,  // 27: tictactoe_battle_ext.TicTacToeBattleExtService.EnterRoom:input_type -> tictactoe_battle_ext.EnterRoomRequest
+	8,  // 28: tictactoe_battle_ext.TicTacToeBattleExtService.GetReplay:input_type -> tictactoe_battle_ext.GetReplayRequest
+	10, // 29: tictactoe_battle_ext.TicTacToeBattleExtService.StreamReplay:input_type -> tictactoe_battle_ext.StreamReplayRequest
+	11, // 30: tictactoe_battle_ext.TicTacToeBattleExtService.LegalMoves:input_type -> tictactoe_battle_ext.LegalMovesRequest
+	13, // 31: tictactoe_battle_ext.TicTacToeBattleExtService.SuggestMove:input_type -> tictactoe_battle_ext.SuggestMoveRequest
+	16, // 32: tictactoe_battle_ext.TicTacToeBattleExtService.PlayGobblet:input_type -> tictactoe_battle_ext.PlayGobbletRequest
+	17, // 33: tictactoe_battle_ext.TicTacToeBattleExtService.GobbletBoard:input_type -> tictactoe_battle_ext.GobbletBoardRequest
+	22, // 34: tictactoe_battle_ext.TicTacToeBattleExtService.Resign:input_type -> tictactoe_battle_ext.ResignRequest
+	23, // 35: tictactoe_battle_ext.TicTacToeBattleExtService.OfferDraw:input_type -> tictactoe_battle_ext.OfferDrawRequest
+	24, // 36: tictactoe_battle_ext.TicTacToeBattleExtService.AcceptDraw:input_type -> tictactoe_battle_ext.AcceptDrawRequest
+	25, // 37: tictactoe_battle_ext.TicTacToeBattleExtService.DeclineDraw:input_type -> tictactoe_battle_ext.DeclineDrawRequest
+	26, // 38: tictactoe_battle_ext.TicTacToeBattleExtService.RequestRematch:input_type -> tictactoe_battle_ext.RequestRematchRequest
+	27, // 39: tictactoe_battle_ext.TicTacToeBattleExtService.AcceptRematch:input_type -> tictactoe_battle_ext.AcceptRematchRequest
+	28, // 40: tictactoe_battle_ext.TicTacToeBattleExtService.GetPlayerProfile:input_type -> tictactoe_battle_ext.GetPlayerProfileRequest
+	30, // 41: tictactoe_battle_ext.TicTacToeBattleExtService.ListMatchHistory:input_type -> tictactoe_battle_ext.ListMatchHistoryRequest
+	35, // 42: tictactoe_battle_ext.TicTacToeBattleExtService.GetLeaderboard:input_type -> tictactoe_battle_ext.GetLeaderboardRequest
+	37, // 43: tictactoe_battle_ext.TicTacToeBattleExtService.GetMyRank:input_type -> tictactoe_battle_ext.GetMyRankRequest
+	39, // 44: tictactoe_battle_ext.TicTacToeBattleExtService.GetSeason:input_type -> tictactoe_battle_ext.GetSeasonRequest
+	41, // 45: tictactoe_battle_ext.TicTacToeBattleExtService.CloseSeason:input_type -> tictactoe_battle_ext.CloseSeasonRequest
+	2,  // 46: tictactoe_battle_ext.TicTacToeBattleExtService.EnterRoom:output_type -> tictactoe_battle_ext.BattleSituation
+	9,  // 47: tictactoe_battle_ext.TicTacToeBattleExtService.GetReplay:output_type -> tictactoe_battle_ext.GetReplayResponse
+	2,  // 48: tictactoe_battle_ext.TicTacToeBattleExtService.StreamReplay:output_type -> tictactoe_battle_ext.BattleSituation
+	12, // 49: tictactoe_battle_ext.TicTacToeBattleExtService.LegalMoves:output_type -> tictactoe_battle_ext.LegalMovesResponse
+	14, // 50: tictactoe_battle_ext.TicTacToeBattleExtService.SuggestMove:output_type -> tictactoe_battle_ext.SuggestMoveResponse
+	47, // 51: tictactoe_battle_ext.TicTacToeBattleExtService.PlayGobblet:output_type -> tictactoe_battle.NoBody
+	21, // 52: tictactoe_battle_ext.TicTacToeBattleExtService.GobbletBoard:output_type -> tictactoe_battle_ext.GobbletBoardResponse
+	47, // 53: tictactoe_battle_ext.TicTacToeBattleExtService.Resign:output_type -> tictactoe_battle.NoBody
+	47, // 54: tictactoe_battle_ext.TicTacToeBattleExtService.OfferDraw:output_type -> tictactoe_battle.NoBody
+	47, // 55: tictactoe_battle_ext.TicTacToeBattleExtService.AcceptDraw:output_type -> tictactoe_battle.NoBody
+	47, // 56: tictactoe_battle_ext.TicTacToeBattleExtService.DeclineDraw:output_type -> tictactoe_battle.NoBody
+	47, // 57: tictactoe_battle_ext.TicTacToeBattleExtService.RequestRematch:output_type -> tictactoe_battle.NoBody
+	47, // 58: tictactoe_battle_ext.TicTacToeBattleExtService.AcceptRematch:output_type -> tictactoe_battle.NoBody
+	29, // 59: tictactoe_battle_ext.TicTacToeBattleExtService.GetPlayerProfile:output_type -> tictactoe_battle_ext.GetPlayerProfileResponse
+	32, // 60: tictactoe_battle_ext.TicTacToeBattleExtService.ListMatchHistory:output_type -> tictactoe_battle_ext.ListMatchHistoryResponse
+	36, // 61: tictactoe_battle_ext.TicTacToeBattleExtService.GetLeaderboard:output_type -> tictactoe_battle_ext.GetLeaderboardResponse
+	38, // 62: tictactoe_battle_ext.TicTacToeBattleExtService.GetMyRank:output_type -> tictactoe_battle_ext.GetMyRankResponse
+	40, // 63: tictactoe_battle_ext.TicTacToeBattleExtService.GetSeason:output_type -> tictactoe_battle_ext.GetSeasonResponse
+	42, // 64: tictactoe_battle_ext.TicTacToeBattleExtService.CloseSeason:output_type -> tictactoe_battle_ext.CloseSeasonResponse
+	46, // [46:65] is the sub-list for method output_type
+	27, // [27:46] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_tictactoe_battle_ext_api_proto_init() }
+func file_tictactoe_battle_ext_api_proto_init() {
+	if File_tictactoe_battle_ext_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tictactoe_battle_ext_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BattleSituation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Series); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ratings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnterRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Action); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReplayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReplayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamReplayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegalMovesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegalMovesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestMoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestMoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GobbletAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayGobbletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GobbletBoardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GobbletPiece); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GobbletCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GobbletLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GobbletBoardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferDrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptDrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineDrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestRematchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptRematchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMatchHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMatchHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Season); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyRankRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyRankResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSeasonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSeasonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSeasonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tictactoe_battle_ext_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSeasonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tictactoe_battle_ext_api_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tictactoe_battle_ext_api_proto_goTypes,
+		DependencyIndexes: file_tictactoe_battle_ext_api_proto_depIdxs,
+		EnumInfos:         file_tictactoe_battle_ext_api_proto_enumTypes,
+		MessageInfos:      file_tictactoe_battle_ext_api_proto_msgTypes,
+	}.Build()
+	File_tictactoe_battle_ext_api_proto = out.File
+	file_tictactoe_battle_ext_api_proto_rawDesc = nil
+	file_tictactoe_battle_ext_api_proto_goTypes = nil
+	file_tictactoe_battle_ext_api_proto_depIdxs = nil
+}
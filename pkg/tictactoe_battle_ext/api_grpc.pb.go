@@ -0,0 +1,806 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package tictactoe_battle_ext
+
+import (
+	context "context"
+	tictactoe_battle "github.com/swallowarc/tictactoe-battle-proto/pkg/tictactoe_battle"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TicTacToeBattleExtServiceClient is the client API for TicTacToeBattleExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TicTacToeBattleExtServiceClient interface {
+	EnterRoom(ctx context.Context, in *EnterRoomRequest, opts ...grpc.CallOption) (TicTacToeBattleExtService_EnterRoomClient, error)
+	GetReplay(ctx context.Context, in *GetReplayRequest, opts ...grpc.CallOption) (*GetReplayResponse, error)
+	StreamReplay(ctx context.Context, in *StreamReplayRequest, opts ...grpc.CallOption) (TicTacToeBattleExtService_StreamReplayClient, error)
+	LegalMoves(ctx context.Context, in *LegalMovesRequest, opts ...grpc.CallOption) (*LegalMovesResponse, error)
+	SuggestMove(ctx context.Context, in *SuggestMoveRequest, opts ...grpc.CallOption) (*SuggestMoveResponse, error)
+	PlayGobblet(ctx context.Context, in *PlayGobbletRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error)
+	GobbletBoard(ctx context.Context, in *GobbletBoardRequest, opts ...grpc.CallOption) (*GobbletBoardResponse, error)
+	Resign(ctx context.Context, in *ResignRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error)
+	OfferDraw(ctx context.Context, in *OfferDrawRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error)
+	AcceptDraw(ctx context.Context, in *AcceptDrawRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error)
+	DeclineDraw(ctx context.Context, in *DeclineDrawRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error)
+	RequestRematch(ctx context.Context, in *RequestRematchRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error)
+	AcceptRematch(ctx context.Context, in *AcceptRematchRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error)
+	GetPlayerProfile(ctx context.Context, in *GetPlayerProfileRequest, opts ...grpc.CallOption) (*GetPlayerProfileResponse, error)
+	ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest, opts ...grpc.CallOption) (*ListMatchHistoryResponse, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	GetMyRank(ctx context.Context, in *GetMyRankRequest, opts ...grpc.CallOption) (*GetMyRankResponse, error)
+	GetSeason(ctx context.Context, in *GetSeasonRequest, opts ...grpc.CallOption) (*GetSeasonResponse, error)
+	CloseSeason(ctx context.Context, in *CloseSeasonRequest, opts ...grpc.CallOption) (*CloseSeasonResponse, error)
+}
+
+type ticTacToeBattleExtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTicTacToeBattleExtServiceClient(cc grpc.ClientConnInterface) TicTacToeBattleExtServiceClient {
+	return &ticTacToeBattleExtServiceClient{cc}
+}
+
+func (c *ticTacToeBattleExtServiceClient) EnterRoom(ctx context.Context, in *EnterRoomRequest, opts ...grpc.CallOption) (TicTacToeBattleExtService_EnterRoomClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TicTacToeBattleExtService_ServiceDesc.Streams[0], "/tictactoe_battle_ext.TicTacToeBattleExtService/EnterRoom", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ticTacToeBattleExtServiceEnterRoomClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TicTacToeBattleExtService_EnterRoomClient interface {
+	Recv() (*BattleSituation, error)
+	grpc.ClientStream
+}
+
+type ticTacToeBattleExtServiceEnterRoomClient struct {
+	grpc.ClientStream
+}
+
+func (x *ticTacToeBattleExtServiceEnterRoomClient) Recv() (*BattleSituation, error) {
+	m := new(BattleSituation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) GetReplay(ctx context.Context, in *GetReplayRequest, opts ...grpc.CallOption) (*GetReplayResponse, error) {
+	out := new(GetReplayResponse)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/GetReplay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) StreamReplay(ctx context.Context, in *StreamReplayRequest, opts ...grpc.CallOption) (TicTacToeBattleExtService_StreamReplayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TicTacToeBattleExtService_ServiceDesc.Streams[1], "/tictactoe_battle_ext.TicTacToeBattleExtService/StreamReplay", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ticTacToeBattleExtServiceStreamReplayClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TicTacToeBattleExtService_StreamReplayClient interface {
+	Recv() (*BattleSituation, error)
+	grpc.ClientStream
+}
+
+type ticTacToeBattleExtServiceStreamReplayClient struct {
+	grpc.ClientStream
+}
+
+func (x *ticTacToeBattleExtServiceStreamReplayClient) Recv() (*BattleSituation, error) {
+	m := new(BattleSituation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) LegalMoves(ctx context.Context, in *LegalMovesRequest, opts ...grpc.CallOption) (*LegalMovesResponse, error) {
+	out := new(LegalMovesResponse)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/LegalMoves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) SuggestMove(ctx context.Context, in *SuggestMoveRequest, opts ...grpc.CallOption) (*SuggestMoveResponse, error) {
+	out := new(SuggestMoveResponse)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/SuggestMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) PlayGobblet(ctx context.Context, in *PlayGobbletRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error) {
+	out := new(tictactoe_battle.NoBody)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/PlayGobblet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) GobbletBoard(ctx context.Context, in *GobbletBoardRequest, opts ...grpc.CallOption) (*GobbletBoardResponse, error) {
+	out := new(GobbletBoardResponse)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/GobbletBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) Resign(ctx context.Context, in *ResignRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error) {
+	out := new(tictactoe_battle.NoBody)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/Resign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) OfferDraw(ctx context.Context, in *OfferDrawRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error) {
+	out := new(tictactoe_battle.NoBody)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/OfferDraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) AcceptDraw(ctx context.Context, in *AcceptDrawRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error) {
+	out := new(tictactoe_battle.NoBody)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/AcceptDraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) DeclineDraw(ctx context.Context, in *DeclineDrawRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error) {
+	out := new(tictactoe_battle.NoBody)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/DeclineDraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) RequestRematch(ctx context.Context, in *RequestRematchRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error) {
+	out := new(tictactoe_battle.NoBody)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/RequestRematch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) AcceptRematch(ctx context.Context, in *AcceptRematchRequest, opts ...grpc.CallOption) (*tictactoe_battle.NoBody, error) {
+	out := new(tictactoe_battle.NoBody)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/AcceptRematch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) GetPlayerProfile(ctx context.Context, in *GetPlayerProfileRequest, opts ...grpc.CallOption) (*GetPlayerProfileResponse, error) {
+	out := new(GetPlayerProfileResponse)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/GetPlayerProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest, opts ...grpc.CallOption) (*ListMatchHistoryResponse, error) {
+	out := new(ListMatchHistoryResponse)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/ListMatchHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) GetMyRank(ctx context.Context, in *GetMyRankRequest, opts ...grpc.CallOption) (*GetMyRankResponse, error) {
+	out := new(GetMyRankResponse)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/GetMyRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) GetSeason(ctx context.Context, in *GetSeasonRequest, opts ...grpc.CallOption) (*GetSeasonResponse, error) {
+	out := new(GetSeasonResponse)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/GetSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeBattleExtServiceClient) CloseSeason(ctx context.Context, in *CloseSeasonRequest, opts ...grpc.CallOption) (*CloseSeasonResponse, error) {
+	out := new(CloseSeasonResponse)
+	err := c.cc.Invoke(ctx, "/tictactoe_battle_ext.TicTacToeBattleExtService/CloseSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TicTacToeBattleExtServiceServer is the server API for TicTacToeBattleExtService service.
+// All implementations must embed UnimplementedTicTacToeBattleExtServiceServer
+// for forward compatibility
+type TicTacToeBattleExtServiceServer interface {
+	EnterRoom(*EnterRoomRequest, TicTacToeBattleExtService_EnterRoomServer) error
+	GetReplay(context.Context, *GetReplayRequest) (*GetReplayResponse, error)
+	StreamReplay(*StreamReplayRequest, TicTacToeBattleExtService_StreamReplayServer) error
+	LegalMoves(context.Context, *LegalMovesRequest) (*LegalMovesResponse, error)
+	SuggestMove(context.Context, *SuggestMoveRequest) (*SuggestMoveResponse, error)
+	PlayGobblet(context.Context, *PlayGobbletRequest) (*tictactoe_battle.NoBody, error)
+	GobbletBoard(context.Context, *GobbletBoardRequest) (*GobbletBoardResponse, error)
+	Resign(context.Context, *ResignRequest) (*tictactoe_battle.NoBody, error)
+	OfferDraw(context.Context, *OfferDrawRequest) (*tictactoe_battle.NoBody, error)
+	AcceptDraw(context.Context, *AcceptDrawRequest) (*tictactoe_battle.NoBody, error)
+	DeclineDraw(context.Context, *DeclineDrawRequest) (*tictactoe_battle.NoBody, error)
+	RequestRematch(context.Context, *RequestRematchRequest) (*tictactoe_battle.NoBody, error)
+	AcceptRematch(context.Context, *AcceptRematchRequest) (*tictactoe_battle.NoBody, error)
+	GetPlayerProfile(context.Context, *GetPlayerProfileRequest) (*GetPlayerProfileResponse, error)
+	ListMatchHistory(context.Context, *ListMatchHistoryRequest) (*ListMatchHistoryResponse, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	GetMyRank(context.Context, *GetMyRankRequest) (*GetMyRankResponse, error)
+	GetSeason(context.Context, *GetSeasonRequest) (*GetSeasonResponse, error)
+	CloseSeason(context.Context, *CloseSeasonRequest) (*CloseSeasonResponse, error)
+	mustEmbedUnimplementedTicTacToeBattleExtServiceServer()
+}
+
+// UnimplementedTicTacToeBattleExtServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTicTacToeBattleExtServiceServer struct {
+}
+
+func (UnimplementedTicTacToeBattleExtServiceServer) EnterRoom(*EnterRoomRequest, TicTacToeBattleExtService_EnterRoomServer) error {
+	return status.Errorf(codes.Unimplemented, "method EnterRoom not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) GetReplay(context.Context, *GetReplayRequest) (*GetReplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplay not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) StreamReplay(*StreamReplayRequest, TicTacToeBattleExtService_StreamReplayServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamReplay not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) LegalMoves(context.Context, *LegalMovesRequest) (*LegalMovesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LegalMoves not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) SuggestMove(context.Context, *SuggestMoveRequest) (*SuggestMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestMove not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) PlayGobblet(context.Context, *PlayGobbletRequest) (*tictactoe_battle.NoBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayGobblet not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) GobbletBoard(context.Context, *GobbletBoardRequest) (*GobbletBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GobbletBoard not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) Resign(context.Context, *ResignRequest) (*tictactoe_battle.NoBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resign not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) OfferDraw(context.Context, *OfferDrawRequest) (*tictactoe_battle.NoBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferDraw not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) AcceptDraw(context.Context, *AcceptDrawRequest) (*tictactoe_battle.NoBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDraw not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) DeclineDraw(context.Context, *DeclineDrawRequest) (*tictactoe_battle.NoBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineDraw not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) RequestRematch(context.Context, *RequestRematchRequest) (*tictactoe_battle.NoBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRematch not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) AcceptRematch(context.Context, *AcceptRematchRequest) (*tictactoe_battle.NoBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptRematch not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) GetPlayerProfile(context.Context, *GetPlayerProfileRequest) (*GetPlayerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerProfile not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) ListMatchHistory(context.Context, *ListMatchHistoryRequest) (*ListMatchHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMatchHistory not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) GetMyRank(context.Context, *GetMyRankRequest) (*GetMyRankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyRank not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) GetSeason(context.Context, *GetSeasonRequest) (*GetSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeason not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) CloseSeason(context.Context, *CloseSeasonRequest) (*CloseSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSeason not implemented")
+}
+func (UnimplementedTicTacToeBattleExtServiceServer) mustEmbedUnimplementedTicTacToeBattleExtServiceServer() {
+}
+
+// UnsafeTicTacToeBattleExtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TicTacToeBattleExtServiceServer will
+// result in compilation errors.
+type UnsafeTicTacToeBattleExtServiceServer interface {
+	mustEmbedUnimplementedTicTacToeBattleExtServiceServer()
+}
+
+func RegisterTicTacToeBattleExtServiceServer(s grpc.ServiceRegistrar, srv TicTacToeBattleExtServiceServer) {
+	s.RegisterService(&TicTacToeBattleExtService_ServiceDesc, srv)
+}
+
+func _TicTacToeBattleExtService_EnterRoom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EnterRoomRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TicTacToeBattleExtServiceServer).EnterRoom(m, &ticTacToeBattleExtServiceEnterRoomServer{stream})
+}
+
+type TicTacToeBattleExtService_EnterRoomServer interface {
+	Send(*BattleSituation) error
+	grpc.ServerStream
+}
+
+type ticTacToeBattleExtServiceEnterRoomServer struct {
+	grpc.ServerStream
+}
+
+func (x *ticTacToeBattleExtServiceEnterRoomServer) Send(m *BattleSituation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TicTacToeBattleExtService_GetReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).GetReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/GetReplay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).GetReplay(ctx, req.(*GetReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_StreamReplay_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamReplayRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TicTacToeBattleExtServiceServer).StreamReplay(m, &ticTacToeBattleExtServiceStreamReplayServer{stream})
+}
+
+type TicTacToeBattleExtService_StreamReplayServer interface {
+	Send(*BattleSituation) error
+	grpc.ServerStream
+}
+
+type ticTacToeBattleExtServiceStreamReplayServer struct {
+	grpc.ServerStream
+}
+
+func (x *ticTacToeBattleExtServiceStreamReplayServer) Send(m *BattleSituation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TicTacToeBattleExtService_LegalMoves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LegalMovesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).LegalMoves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/LegalMoves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).LegalMoves(ctx, req.(*LegalMovesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_SuggestMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).SuggestMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/SuggestMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).SuggestMove(ctx, req.(*SuggestMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_PlayGobblet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayGobbletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).PlayGobblet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/PlayGobblet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).PlayGobblet(ctx, req.(*PlayGobbletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_GobbletBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GobbletBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).GobbletBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/GobbletBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).GobbletBoard(ctx, req.(*GobbletBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_Resign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).Resign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/Resign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).Resign(ctx, req.(*ResignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_OfferDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OfferDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).OfferDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/OfferDraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).OfferDraw(ctx, req.(*OfferDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_AcceptDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).AcceptDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/AcceptDraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).AcceptDraw(ctx, req.(*AcceptDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_DeclineDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).DeclineDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/DeclineDraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).DeclineDraw(ctx, req.(*DeclineDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_RequestRematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRematchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).RequestRematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/RequestRematch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).RequestRematch(ctx, req.(*RequestRematchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_AcceptRematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptRematchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).AcceptRematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/AcceptRematch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).AcceptRematch(ctx, req.(*AcceptRematchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_GetPlayerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).GetPlayerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/GetPlayerProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).GetPlayerProfile(ctx, req.(*GetPlayerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_ListMatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).ListMatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/ListMatchHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).ListMatchHistory(ctx, req.(*ListMatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_GetMyRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).GetMyRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/GetMyRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).GetMyRank(ctx, req.(*GetMyRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_GetSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).GetSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/GetSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).GetSeason(ctx, req.(*GetSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToeBattleExtService_CloseSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeBattleExtServiceServer).CloseSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tictactoe_battle_ext.TicTacToeBattleExtService/CloseSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeBattleExtServiceServer).CloseSeason(ctx, req.(*CloseSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TicTacToeBattleExtService_ServiceDesc is the grpc.ServiceDesc for TicTacToeBattleExtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TicTacToeBattleExtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tictactoe_battle_ext.TicTacToeBattleExtService",
+	HandlerType: (*TicTacToeBattleExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetReplay",
+			Handler:    _TicTacToeBattleExtService_GetReplay_Handler,
+		},
+		{
+			MethodName: "LegalMoves",
+			Handler:    _TicTacToeBattleExtService_LegalMoves_Handler,
+		},
+		{
+			MethodName: "SuggestMove",
+			Handler:    _TicTacToeBattleExtService_SuggestMove_Handler,
+		},
+		{
+			MethodName: "PlayGobblet",
+			Handler:    _TicTacToeBattleExtService_PlayGobblet_Handler,
+		},
+		{
+			MethodName: "GobbletBoard",
+			Handler:    _TicTacToeBattleExtService_GobbletBoard_Handler,
+		},
+		{
+			MethodName: "Resign",
+			Handler:    _TicTacToeBattleExtService_Resign_Handler,
+		},
+		{
+			MethodName: "OfferDraw",
+			Handler:    _TicTacToeBattleExtService_OfferDraw_Handler,
+		},
+		{
+			MethodName: "AcceptDraw",
+			Handler:    _TicTacToeBattleExtService_AcceptDraw_Handler,
+		},
+		{
+			MethodName: "DeclineDraw",
+			Handler:    _TicTacToeBattleExtService_DeclineDraw_Handler,
+		},
+		{
+			MethodName: "RequestRematch",
+			Handler:    _TicTacToeBattleExtService_RequestRematch_Handler,
+		},
+		{
+			MethodName: "AcceptRematch",
+			Handler:    _TicTacToeBattleExtService_AcceptRematch_Handler,
+		},
+		{
+			MethodName: "GetPlayerProfile",
+			Handler:    _TicTacToeBattleExtService_GetPlayerProfile_Handler,
+		},
+		{
+			MethodName: "ListMatchHistory",
+			Handler:    _TicTacToeBattleExtService_ListMatchHistory_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _TicTacToeBattleExtService_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetMyRank",
+			Handler:    _TicTacToeBattleExtService_GetMyRank_Handler,
+		},
+		{
+			MethodName: "GetSeason",
+			Handler:    _TicTacToeBattleExtService_GetSeason_Handler,
+		},
+		{
+			MethodName: "CloseSeason",
+			Handler:    _TicTacToeBattleExtService_CloseSeason_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EnterRoom",
+			Handler:       _TicTacToeBattleExtService_EnterRoom_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamReplay",
+			Handler:       _TicTacToeBattleExtService_StreamReplay_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tictactoe_battle_ext/api.proto",
+}